@@ -0,0 +1,184 @@
+package semver
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// PrevPatch returns the previous patch version: 1.2.3 and 1.2.3-beta become 1.2.2.
+// Prerelease and build meta data are discarded.
+// An error is returned if the patch version is zero.
+func (v Version) PrevPatch() (Version, error) {
+	if v.Patch == 0 {
+		return Version{}, errors.New("Patch version underflow")
+	}
+	return Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch - 1}, nil
+}
+
+// PrevMinor returns the first release of the previous minor version:
+// 1.2.3 becomes 1.1.0. Prerelease and build meta data are discarded.
+// An error is returned if the minor version is zero.
+func (v Version) PrevMinor() (Version, error) {
+	if v.Minor == 0 {
+		return Version{}, errors.New("Minor version underflow")
+	}
+	return Version{Major: v.Major, Minor: v.Minor - 1}, nil
+}
+
+// PrevMajor returns the first release of the previous major version:
+// 2.1.3 becomes 1.0.0. Prerelease and build meta data are discarded.
+// An error is returned if the major version is zero.
+func (v Version) PrevMajor() (Version, error) {
+	if v.Major == 0 {
+		return Version{}, errors.New("Major version underflow")
+	}
+	return Version{Major: v.Major - 1}, nil
+}
+
+// Successor returns the smallest version greater than v, ignoring build
+// meta data: 1.2.3 becomes 1.2.4-0 and 1.2.3-rc.1 becomes 1.2.3-rc.1.0.
+// ok is false if v is the greatest possible version.
+func (v Version) Successor() (s Version, ok bool) {
+	s = Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+	if len(v.Pre) > 0 {
+		// Appending the smallest identifier yields the next prerelease
+		s.Pre = make([]PRVersion, len(v.Pre), len(v.Pre)+1)
+		copy(s.Pre, v.Pre)
+		s.Pre = append(s.Pre, minVersion.Pre[0])
+		return s, true
+	}
+	switch {
+	case s.Patch+1 != 0:
+		s.Patch++
+	case s.Minor+1 != 0:
+		s.Minor++
+		s.Patch = 0
+	case s.Major+1 != 0:
+		s.Major++
+		s.Minor = 0
+		s.Patch = 0
+	default:
+		return Version{}, false
+	}
+	s.Pre = minPrerelease()
+	return s, true
+}
+
+// minPrerelease returns the prerelease versions of the first prerelease
+// of a release, "0", as a new slice the caller may modify.
+func minPrerelease() []PRVersion {
+	return []PRVersion{{VersionNum: 0, IsNum: true}}
+}
+
+// Predecessor returns the greatest version less than v, ignoring build
+// meta data, so that v is its Successor: 1.2.4-0 becomes 1.2.3 and
+// 1.2.3-rc.1.0 becomes 1.2.3-rc.1.
+// As prerelease identifiers are unbounded, most versions have no greatest
+// version below them, e.g. 1.2.3-rc.2 follows 1.2.3-rc.1.x for any x.
+// ok is false in this case and for the smallest possible version 0.0.0-0.
+func (v Version) Predecessor() (p Version, ok bool) {
+	n := len(v.Pre)
+	if n == 0 || v.Pre[n-1].Compare(minVersion.Pre[0]) != 0 {
+		return Version{}, false
+	}
+	p = Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+	if n > 1 {
+		p.Pre = make([]PRVersion, n-1)
+		copy(p.Pre, v.Pre)
+		return p, true
+	}
+	switch {
+	case p.Patch > 0:
+		p.Patch--
+	case p.Minor > 0:
+		p.Minor--
+		p.Patch = math.MaxUint64
+	case p.Major > 0:
+		p.Major--
+		p.Minor = math.MaxUint64
+		p.Patch = math.MaxUint64
+	default:
+		return Version{}, false
+	}
+	return p, true
+}
+
+// Enumerator steps through the releases between two versions, see Enumerate.
+//
+//	e, _ := semver.Enumerate(semver.MustParse("1.2.0"), semver.MustParse("1.5.0"), semver.ComponentMinor)
+//	for e.Next() {
+//		fmt.Println(e.Version()) // prints 1.2.0, 1.3.0, 1.4.0 and 1.5.0
+//	}
+type Enumerator struct {
+	cur, to Version
+	step    Component
+	started bool
+	done    bool
+}
+
+// Enumerate returns an Enumerator over all releases v with from <= v <= to
+// whose components below step are zero. step must be ComponentMajor,
+// ComponentMinor or ComponentPatch, and from and to must not differ in a
+// component above step, as the number of releases in between would be unbounded.
+func Enumerate(from, to Version, step Component) (*Enumerator, error) {
+	first := Version{Major: from.Major}
+	switch step {
+	case ComponentPatch:
+		if from.Major != to.Major || from.Minor != to.Minor {
+			return nil, fmt.Errorf("Cannot enumerate from %s to %s by %s version", from, to, step)
+		}
+		first.Minor = from.Minor
+		first.Patch = from.Patch
+	case ComponentMinor:
+		if from.Major != to.Major {
+			return nil, fmt.Errorf("Cannot enumerate from %s to %s by %s version", from, to, step)
+		}
+		first.Minor = from.Minor
+	case ComponentMajor:
+	default:
+		return nil, fmt.Errorf("Cannot enumerate by %s version", step)
+	}
+	e := &Enumerator{cur: first, to: to, step: step}
+	if first.LT(from) {
+		e.done = !e.increment()
+	}
+	return e, nil
+}
+
+// Next advances the Enumerator to the next version, which is then available
+// through Version. It returns false when the enumeration is finished.
+func (e *Enumerator) Next() bool {
+	if e.done {
+		return false
+	}
+	if e.started && !e.increment() {
+		e.done = true
+		return false
+	}
+	e.started = true
+	if e.cur.GT(e.to) {
+		e.done = true
+		return false
+	}
+	return true
+}
+
+// Version returns the current version of the Enumerator.
+func (e *Enumerator) Version() Version {
+	return e.cur
+}
+
+// increment steps to the next version, it returns false on overflow.
+func (e *Enumerator) increment() bool {
+	var err error
+	switch e.step {
+	case ComponentPatch:
+		err = e.cur.IncrementPatch()
+	case ComponentMinor:
+		err = e.cur.IncrementMinor()
+	case ComponentMajor:
+		err = e.cur.IncrementMajor()
+	}
+	return err == nil
+}
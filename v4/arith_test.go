@@ -0,0 +1,173 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestPrevVersions(t *testing.T) {
+	tests := []struct {
+		v                   string
+		patch, minor, major string
+	}{
+		{"1.2.3", "1.2.2", "1.1.0", "0.0.0"},
+		{"1.2.3-beta+build", "1.2.2", "1.1.0", "0.0.0"},
+		{"1.2.0", "", "1.1.0", "0.0.0"},
+		{"1.0.3", "1.0.2", "", "0.0.0"},
+		{"0.2.3", "0.2.2", "0.1.0", ""},
+	}
+	for _, tc := range tests {
+		v := MustParse(tc.v)
+		for _, p := range []struct {
+			name string
+			prev func() (Version, error)
+			s    string
+		}{
+			{"PrevPatch", v.PrevPatch, tc.patch},
+			{"PrevMinor", v.PrevMinor, tc.minor},
+			{"PrevMajor", v.PrevMajor, tc.major},
+		} {
+			res, err := p.prev()
+			if p.s == "" {
+				if err == nil {
+					t.Errorf("%s of %q: expected error, got %q", p.name, tc.v, res)
+				}
+			} else if err != nil {
+				t.Errorf("%s of %q: unexpected error %q", p.name, tc.v, err)
+			} else if res.String() != p.s {
+				t.Errorf("%s of %q: expected %q, got %q", p.name, tc.v, p.s, res)
+			}
+		}
+	}
+}
+
+func TestSuccessor(t *testing.T) {
+	tests := []struct {
+		v  string
+		s  string
+		ok bool
+	}{
+		{"1.0.0", "1.0.1-0", true},
+		{"1.0.0+build", "1.0.1-0", true},
+		{"1.0.0-rc.1", "1.0.0-rc.1.0", true},
+		{"1.0.18446744073709551615", "1.1.0-0", true},
+		{"1.18446744073709551615.18446744073709551615", "2.0.0-0", true},
+		{"18446744073709551615.18446744073709551615.18446744073709551615", "", false},
+	}
+	for _, tc := range tests {
+		v := MustParse(tc.v)
+		s, ok := v.Successor()
+		if ok != tc.ok {
+			t.Errorf("Invalid for case %q: Expected ok %t, got %t", tc.v, tc.ok, ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if s.String() != tc.s {
+			t.Errorf("Invalid for case %q: Expected %q, got %q", tc.v, tc.s, s)
+		}
+		if !s.GT(v) {
+			t.Errorf("Invalid for case %q: %q is not greater", tc.v, s)
+		}
+		if p, ok := s.Predecessor(); !ok || !p.Equals(v) {
+			t.Errorf("Invalid predecessor for case %q: Expected %q, got %q (%t)", tc.s, tc.v, p, ok)
+		}
+	}
+}
+
+func TestSuccessorDoesNotAlias(t *testing.T) {
+	s, _ := MustParse("1.2.3").Successor()
+	if err := s.ParseInto("2.0.0-beta"); err != nil {
+		t.Fatal(err)
+	}
+	if s, _ := MustParse("1.2.3").Successor(); s.String() != "1.2.4-0" {
+		t.Errorf("Invalid successor after modifying a previous one: %q", s)
+	}
+	if s := MustParse("1.0.0").CaretRange().String(); s != ">=1.0.0 <2.0.0-0" {
+		t.Errorf("Invalid caret range after modifying a successor: %q", s)
+	}
+	if s := (RangeSet{}).String(); s != "<0.0.0-0" {
+		t.Errorf("Invalid empty range after modifying a successor: %q", s)
+	}
+}
+
+func TestPredecessor(t *testing.T) {
+	tests := []struct {
+		v  string
+		p  string
+		ok bool
+	}{
+		{"1.2.4-0", "1.2.3", true},
+		{"1.2.3-rc.1.0", "1.2.3-rc.1", true},
+		{"1.2.0-0", "1.1.18446744073709551615", true},
+		{"1.0.0-0", "0.18446744073709551615.18446744073709551615", true},
+		{"1.2.3", "", false},
+		{"1.2.3-rc.1", "", false},
+		{"1.2.3-0.a", "", false},
+		{"0.0.0-0", "", false},
+	}
+	for _, tc := range tests {
+		v := MustParse(tc.v)
+		p, ok := v.Predecessor()
+		if ok != tc.ok {
+			t.Errorf("Invalid for case %q: Expected ok %t, got %t", tc.v, tc.ok, ok)
+			continue
+		}
+		if ok && p.String() != tc.p {
+			t.Errorf("Invalid for case %q: Expected %q, got %q", tc.v, tc.p, p)
+		}
+	}
+}
+
+func TestEnumerate(t *testing.T) {
+	tests := []struct {
+		from, to string
+		step     Component
+		result   []string
+	}{
+		{"1.2.0", "1.5.0", ComponentMinor, []string{"1.2.0", "1.3.0", "1.4.0", "1.5.0"}},
+		{"1.2.3", "1.5.0-rc.1", ComponentMinor, []string{"1.3.0", "1.4.0"}},
+		{"1.2.0-rc.1", "1.3.0", ComponentMinor, []string{"1.2.0", "1.3.0"}},
+		{"1.2.3-rc.1", "1.2.5+build", ComponentPatch, []string{"1.2.3", "1.2.4", "1.2.5"}},
+		{"0.9.0", "3.2.1", ComponentMajor, []string{"1.0.0", "2.0.0", "3.0.0"}},
+		{"1.5.0", "1.2.0", ComponentMinor, nil},
+		{"1.2.18446744073709551614", "1.2.18446744073709551615", ComponentPatch, []string{"1.2.18446744073709551614", "1.2.18446744073709551615"}},
+	}
+	for _, tc := range tests {
+		e, err := Enumerate(MustParse(tc.from), MustParse(tc.to), tc.step)
+		if err != nil {
+			t.Errorf("Unexpected error for %q to %q by %s: %q", tc.from, tc.to, tc.step, err)
+			continue
+		}
+		var res []string
+		for e.Next() {
+			res = append(res, e.Version().String())
+		}
+		if e.Next() {
+			t.Errorf("Enumerator for %q to %q by %s continued after the end", tc.from, tc.to, tc.step)
+		}
+		if len(res) != len(tc.result) {
+			t.Errorf("Invalid for %q to %q by %s: Expected %q, got %q", tc.from, tc.to, tc.step, tc.result, res)
+			continue
+		}
+		for i := range res {
+			if res[i] != tc.result[i] {
+				t.Errorf("Invalid for %q to %q by %s: Expected %q, got %q", tc.from, tc.to, tc.step, tc.result, res)
+				break
+			}
+		}
+	}
+
+	for _, tc := range []struct {
+		from, to string
+		step     Component
+	}{
+		{"1.2.0", "2.0.0", ComponentMinor},
+		{"1.2.0", "1.3.0", ComponentPatch},
+		{"1.2.0", "1.3.0", ComponentPrerelease},
+	} {
+		if _, err := Enumerate(MustParse(tc.from), MustParse(tc.to), tc.step); err == nil {
+			t.Errorf("Expected error for %q to %q by %s", tc.from, tc.to, tc.step)
+		}
+	}
+}
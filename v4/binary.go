@@ -0,0 +1,287 @@
+package semver
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+// Tags of the ordered key encoding.
+const (
+	keyPrerelease byte = 0x00 // version has prerelease versions, sorts before keyRelease
+	keyRelease    byte = 0x01 // version has no prerelease versions
+	keyEnd        byte = 0x00 // end of prerelease versions or build meta data
+	keyNumeric    byte = 0x01 // numeric prerelease version
+	keyAlpha      byte = 0x02 // alphanumeric prerelease version, build meta data
+
+	// keyLongNum prefixes the 4 byte length of numbers of at least keyLongNum bytes
+	keyLongNum byte = 0xff
+)
+
+// maxKeyNumBytes is the largest number of bytes of a numeric prerelease
+// version the ordered key encoding supports.
+const maxKeyNumBytes = math.MaxUint32
+
+// OrderedKey returns an encoding of v's precedence whose byte-wise order,
+// as by bytes.Compare, matches the order of Version.Compare.
+// Versions of equal precedence have equal keys, build meta data is not encoded.
+// This allows range scans over versions in ordered key-value stores.
+//
+// Numbers are encoded by their length followed by their big endian bytes,
+// prerelease versions and alphanumeric identifiers are terminated by 0x00.
+// Numbers of 255 bytes and more, which only occur in large numeric
+// prerelease versions, have the length 0xff followed by their 4 byte length.
+// An error is returned if a numeric prerelease version is invalid.
+func (v Version) OrderedKey() ([]byte, error) {
+	b := make([]byte, 0, 16)
+	return v.appendOrderedKey(b)
+}
+
+func (v Version) appendOrderedKey(b []byte) ([]byte, error) {
+	b = appendKeyNum(b, v.Major)
+	b = appendKeyNum(b, v.Minor)
+	b = appendKeyNum(b, v.Patch)
+	if len(v.Pre) == 0 {
+		return append(b, keyRelease), nil
+	}
+	b = append(b, keyPrerelease)
+	for _, pre := range v.Pre {
+		switch {
+		case pre.IsNum && len(pre.VersionStr) > 0:
+			n, ok := new(big.Int).SetString(pre.VersionStr, 10)
+			if !ok || !containsOnly(pre.VersionStr, numbers) || hasLeadingZeroes(pre.VersionStr) || n.IsUint64() {
+				return nil, fmt.Errorf("Invalid numeric prerelease version %q", pre.VersionStr)
+			}
+			nb := n.Bytes()
+			if uint64(len(nb)) > maxKeyNumBytes {
+				return nil, errors.New("Numeric prerelease version too large for ordered key")
+			}
+			b = append(b, keyNumeric)
+			if len(nb) < int(keyLongNum) {
+				b = append(b, byte(len(nb)))
+			} else {
+				var l [4]byte
+				binary.BigEndian.PutUint32(l[:], uint32(len(nb)))
+				b = append(b, keyLongNum)
+				b = append(b, l[:]...)
+			}
+			b = append(b, nb...)
+		case pre.IsNum:
+			b = append(b, keyNumeric)
+			b = appendKeyNum(b, pre.VersionNum)
+		default:
+			b = append(b, keyAlpha)
+			b = append(b, pre.VersionStr...)
+			b = append(b, keyEnd)
+		}
+	}
+	return append(b, keyEnd), nil
+}
+
+// appendKeyNum appends n as its length in bytes followed by its big endian bytes.
+func appendKeyNum(b []byte, n uint64) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], n)
+	i := 0
+	for i < 8 && buf[i] == 0 {
+		i++
+	}
+	b = append(b, byte(8-i))
+	return append(b, buf[i:]...)
+}
+
+// ParseOrderedKey decodes a key created by Version.OrderedKey.
+func ParseOrderedKey(b []byte) (Version, error) {
+	v, rest, err := decodeOrderedKey(b)
+	if err != nil {
+		return Version{}, err
+	}
+	if len(rest) > 0 {
+		return Version{}, errors.New("Trailing data after ordered key")
+	}
+	return v, nil
+}
+
+var errShortKey = errors.New("Ordered key too short")
+
+// decodeOrderedKey decodes an ordered key at the start of b and returns
+// the remaining bytes.
+func decodeOrderedKey(b []byte) (v Version, rest []byte, err error) {
+	if v.Major, b, err = decodeKeyNum(b); err != nil {
+		return Version{}, nil, err
+	}
+	if v.Minor, b, err = decodeKeyNum(b); err != nil {
+		return Version{}, nil, err
+	}
+	if v.Patch, b, err = decodeKeyNum(b); err != nil {
+		return Version{}, nil, err
+	}
+	if len(b) == 0 {
+		return Version{}, nil, errShortKey
+	}
+	tag := b[0]
+	b = b[1:]
+	switch tag {
+	case keyRelease:
+		return v, b, nil
+	case keyPrerelease:
+	default:
+		return Version{}, nil, errors.New("Invalid prerelease tag in ordered key")
+	}
+	for {
+		if len(b) == 0 {
+			return Version{}, nil, errShortKey
+		}
+		tag = b[0]
+		b = b[1:]
+		switch tag {
+		case keyEnd:
+			if len(v.Pre) == 0 {
+				return Version{}, nil, errors.New("Empty prerelease in ordered key")
+			}
+			return v, b, nil
+		case keyNumeric:
+			if len(b) > 0 && b[0] > 8 {
+				var pre PRVersion
+				if pre, b, err = decodeLargeKeyNum(b); err != nil {
+					return Version{}, nil, err
+				}
+				v.Pre = append(v.Pre, pre)
+				continue
+			}
+			var num uint64
+			if num, b, err = decodeKeyNum(b); err != nil {
+				return Version{}, nil, err
+			}
+			v.Pre = append(v.Pre, PRVersion{VersionNum: num, IsNum: true})
+		case keyAlpha:
+			var s string
+			if s, b, err = decodeKeyString(b); err != nil {
+				return Version{}, nil, err
+			}
+			pre, err := NewPRVersion(s)
+			if err != nil || pre.IsNum {
+				return Version{}, nil, errors.New("Invalid prerelease in ordered key")
+			}
+			v.Pre = append(v.Pre, pre)
+		default:
+			return Version{}, nil, errors.New("Invalid prerelease tag in ordered key")
+		}
+	}
+}
+
+// decodeKeyNum decodes a number encoded by appendKeyNum.
+func decodeKeyNum(b []byte) (uint64, []byte, error) {
+	if len(b) == 0 {
+		return 0, nil, errShortKey
+	}
+	n := int(b[0])
+	if n > 8 {
+		return 0, nil, errors.New("Invalid number length in ordered key")
+	}
+	if len(b) < n+1 {
+		return 0, nil, errShortKey
+	}
+	if n > 0 && b[1] == 0 {
+		return 0, nil, errors.New("Invalid number in ordered key")
+	}
+	var num uint64
+	for _, c := range b[1 : n+1] {
+		num = num<<8 | uint64(c)
+	}
+	return num, b[n+1:], nil
+}
+
+// decodeLargeKeyNum decodes a numeric prerelease version exceeding uint64.
+func decodeLargeKeyNum(b []byte) (PRVersion, []byte, error) {
+	n, start := uint64(b[0]), uint64(1)
+	if b[0] == keyLongNum {
+		if len(b) < 5 {
+			return PRVersion{}, nil, errShortKey
+		}
+		n, start = uint64(binary.BigEndian.Uint32(b[1:5])), 5
+		if n < uint64(keyLongNum) {
+			return PRVersion{}, nil, errors.New("Invalid number length in ordered key")
+		}
+	}
+	if uint64(len(b)) < start+n {
+		return PRVersion{}, nil, errShortKey
+	}
+	// Only canonical numbers exceeding uint64 are encoded this way
+	nb := b[start : start+n]
+	num := new(big.Int).SetBytes(nb)
+	if nb[0] == 0 || num.IsUint64() {
+		return PRVersion{}, nil, errors.New("Invalid number in ordered key")
+	}
+	pre := PRVersion{
+		VersionStr: num.String(),
+		VersionNum: math.MaxUint64,
+		IsNum:      true,
+	}
+	return pre, b[start+n:], nil
+}
+
+// decodeKeyString decodes an identifier terminated by keyEnd.
+func decodeKeyString(b []byte) (string, []byte, error) {
+	for i, c := range b {
+		if c == keyEnd {
+			return string(b[:i]), b[i+1:], nil
+		}
+	}
+	return "", nil, errShortKey
+}
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// The encoding is the OrderedKey of v followed by its build meta data,
+// so it keeps the order of Version.Compare as well.
+func (v Version) MarshalBinary() ([]byte, error) {
+	if err := v.Validate(); err != nil {
+		return nil, err
+	}
+	b, err := v.appendOrderedKey(make([]byte, 0, 32))
+	if err != nil {
+		return nil, err
+	}
+	for _, build := range v.Build {
+		b = append(b, keyAlpha)
+		b = append(b, build...)
+		b = append(b, keyEnd)
+	}
+	return append(b, keyEnd), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+func (v *Version) UnmarshalBinary(data []byte) error {
+	res, b, err := decodeOrderedKey(data)
+	if err != nil {
+		return err
+	}
+	for {
+		if len(b) == 0 {
+			return errShortKey
+		}
+		tag := b[0]
+		b = b[1:]
+		if tag == keyEnd {
+			break
+		}
+		if tag != keyAlpha {
+			return errors.New("Invalid build meta data tag in binary version")
+		}
+		var s string
+		if s, b, err = decodeKeyString(b); err != nil {
+			return err
+		}
+		if _, err := NewBuildVersion(s); err != nil {
+			return err
+		}
+		res.Build = append(res.Build, s)
+	}
+	if len(b) > 0 {
+		return errors.New("Trailing data after binary version")
+	}
+	*v = res
+	return nil
+}
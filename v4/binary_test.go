@@ -0,0 +1,237 @@
+package semver
+
+import (
+	"bytes"
+	"encoding/gob"
+	"math/big"
+	"sort"
+	"strings"
+	"testing"
+)
+
+var orderedKeyVersions = []string{
+	"0.0.0-0",
+	"0.0.0",
+	"0.0.1",
+	"1.0.0-0",
+	"1.0.0-1",
+	"1.0.0-1.0",
+	"1.0.0-255",
+	"1.0.0-256",
+	"1.0.0-18446744073709551615",
+	"1.0.0-20261017123456789012",
+	"1.0.0-A",
+	"1.0.0-alpha",
+	"1.0.0-alpha.1",
+	"1.0.0-alpha.beta",
+	"1.0.0-alpha-",
+	"1.0.0-alphabet",
+	"1.0.0-beta",
+	"1.0.0-beta.2",
+	"1.0.0-beta.11",
+	"1.0.0-rc.1",
+	"1.0.0",
+	"1.0.255",
+	"1.0.256",
+	"1.2.3",
+	"1.10.0",
+	"2.0.0",
+	"18446744073709551615.0.0",
+}
+
+func mustOrderedKey(v Version) []byte {
+	k, err := v.OrderedKey()
+	if err != nil {
+		panic(err)
+	}
+	return k
+}
+
+func TestOrderedKeyOrder(t *testing.T) {
+	opts := ParseOptions{LargePrerelease: true}
+	var versions []Version
+	for _, s := range orderedKeyVersions {
+		v, err := ParseWithOptions(s, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		versions = append(versions, v)
+	}
+	for i, a := range versions {
+		for j, b := range versions {
+			ka, kb := mustOrderedKey(a), mustOrderedKey(b)
+			if c := bytes.Compare(ka, kb); c != a.Compare(b) {
+				t.Errorf("Order of keys %q (%d), %q (%d) does not match: Expected %d, got %d", a, i, b, j, a.Compare(b), c)
+			}
+		}
+	}
+
+	keys := make([][]byte, len(versions))
+	for i, v := range versions {
+		keys[len(keys)-1-i] = mustOrderedKey(v)
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i], keys[j]) < 0 })
+	for i, k := range keys {
+		v, err := ParseOrderedKey(k)
+		if err != nil {
+			t.Errorf("Error decoding key of %q: %q", versions[i], err)
+		} else if v.String() != versions[i].String() {
+			t.Errorf("Invalid decoded key: Expected %q, got %q", versions[i], v)
+		}
+	}
+}
+
+func TestOrderedKeyIgnoresBuild(t *testing.T) {
+	a := mustOrderedKey(MustParse("1.0.0-rc.1+build.1"))
+	b := mustOrderedKey(MustParse("1.0.0-rc.1+build.2"))
+	if !bytes.Equal(a, b) {
+		t.Errorf("Expected equal keys, got %x and %x", a, b)
+	}
+}
+
+func TestParseOrderedKeyErrors(t *testing.T) {
+	valid := mustOrderedKey(MustParse("1.2.3-alpha.1"))
+	for i := 0; i < len(valid); i++ {
+		if v, err := ParseOrderedKey(valid[:i]); err == nil {
+			t.Errorf("Expected error for truncated key %x, got %q", valid[:i], v)
+		}
+	}
+	invalid := [][]byte{
+		append(mustOrderedKey(MustParse("1.2.3")), 0),
+		{9, 0, 0, 1},
+		{1, 1, 0, 0, 2},
+		{1, 1, 0, 0, 0, 0},
+		{1, 1, 0, 0, 0, 3, 0},
+		{1, 1, 0, 0, 0, 2, '1', 0, 0},
+		{1, 1, 0, 0, 0, 2, '!', 0, 0},
+		// Leading zero bytes
+		{2, 0, 1, 0, 0, 1},
+		{1, 1, 0, 0, 0, 1, 2, 0, 5, 0},
+		{1, 1, 0, 0, 0, 1, 10, 0, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		// Long lengths below 255 bytes
+		{1, 1, 0, 0, 0, 1, 255, 0, 0, 0, 9, 1, 0, 0, 0, 0, 0, 0, 0, 0, 0},
+		{1, 1, 0, 0, 0, 1, 255, 0, 0},
+		// Large numbers fitting into uint64
+		{1, 1, 0, 0, 0, 1, 9, 0, 0, 0, 0, 0, 0, 0, 0, 5, 0},
+		{1, 1, 0, 0, 0, 1, 9, 0, 255, 255, 255, 255, 255, 255, 255, 255, 0},
+	}
+	for _, k := range invalid {
+		if v, err := ParseOrderedKey(k); err == nil {
+			t.Errorf("Expected error for key %x, got %q", k, v)
+		}
+	}
+}
+
+func TestOrderedKeyLargeNumbers(t *testing.T) {
+	// Numbers of 254 to 256 bytes around the switch to a 4 byte length
+	var nums []*big.Int
+	for _, n := range []uint{254, 255, 256} {
+		min := new(big.Int).Lsh(big.NewInt(1), 8*(n-1))
+		max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 8*n), big.NewInt(1))
+		nums = append(nums, min, max)
+	}
+	versions := []Version{MustParse("1.0.0-5")}
+	for _, n := range nums {
+		pre, err := NewLargePRVersion(n.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		versions = append(versions, Version{Major: 1, Pre: []PRVersion{pre}})
+	}
+	pre, _ := NewLargePRVersion("1" + strings.Repeat("0", 616))
+	versions = append(versions, Version{Major: 1, Pre: []PRVersion{pre}})
+
+	for i, a := range versions {
+		ka := mustOrderedKey(a)
+		if v, err := ParseOrderedKey(ka); err != nil || !v.Equals(a) {
+			t.Errorf("Invalid decoded key of version %d: %v", i, err)
+		}
+		for j, b := range versions {
+			if c := bytes.Compare(ka, mustOrderedKey(b)); c != a.Compare(b) {
+				t.Errorf("Order of keys %d, %d does not match: Expected %d, got %d", i, j, a.Compare(b), c)
+			}
+		}
+	}
+
+	invalid := []Version{
+		{Pre: []PRVersion{{VersionStr: "abc", VersionNum: 1<<64 - 1, IsNum: true}}},
+		{Pre: []PRVersion{{VersionStr: "-123456789012345678901", VersionNum: 1<<64 - 1, IsNum: true}}},
+		{Pre: []PRVersion{{VersionStr: "0123456789012345678901", VersionNum: 1<<64 - 1, IsNum: true}}},
+		{Pre: []PRVersion{{VersionStr: "5", VersionNum: 1<<64 - 1, IsNum: true}}},
+	}
+	for _, v := range invalid {
+		if k, err := v.OrderedKey(); err == nil {
+			t.Errorf("Expected error for %q, got %x", v.Pre[0].VersionStr, k)
+		}
+	}
+}
+
+func TestBinaryMarshal(t *testing.T) {
+	for _, s := range append(orderedKeyVersions[:len(orderedKeyVersions):len(orderedKeyVersions)], "1.2.3+build.1", "1.2.3-rc.1+b-uild.001") {
+		v, err := ParseWithOptions(s, ParseOptions{LargePrerelease: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := v.MarshalBinary()
+		if err != nil {
+			t.Fatalf("Error marshaling %q: %q", s, err)
+		}
+		var res Version
+		if err := res.UnmarshalBinary(data); err != nil {
+			t.Errorf("Error unmarshaling %q: %q", s, err)
+		} else if res.String() != s {
+			t.Errorf("Binary unmarshaled version not equal: Expected %q, got %q", s, res)
+		}
+	}
+
+	a, _ := MustParse("1.0.0-rc.1+zzz").MarshalBinary()
+	b, _ := MustParse("1.0.0+aaa").MarshalBinary()
+	if bytes.Compare(a, b) >= 0 {
+		t.Errorf("Expected binary encoding to keep precedence order")
+	}
+
+	var res Version
+	invalid := Version{Major: 1, Build: []string{"?"}}
+	if _, err := invalid.MarshalBinary(); err == nil {
+		t.Errorf("Expected error marshaling invalid version")
+	}
+	large := Version{Pre: []PRVersion{{VersionStr: strings.Repeat("9", 700), VersionNum: 1<<64 - 1, IsNum: true}}}
+	if data, err := large.MarshalBinary(); err != nil {
+		t.Errorf("Error marshaling large prerelease: %q", err)
+	} else if err := res.UnmarshalBinary(data); err != nil || !res.StrictEquals(large) {
+		t.Errorf("Binary unmarshaled version not equal: Expected %q, got %q (%v)", large, res, err)
+	}
+
+	data, _ := MustParse("1.2.3+build").MarshalBinary()
+	for i := 0; i < len(data); i++ {
+		if err := res.UnmarshalBinary(data[:i]); err == nil {
+			t.Errorf("Expected error for truncated data %x, got %q", data[:i], res)
+		}
+	}
+	for _, d := range [][]byte{append(data, 0), append(data[:len(data)-1:len(data)-1], 3, 0), append(data[:len(data)-1:len(data)-1], 2, '?', 0, 0)} {
+		if err := res.UnmarshalBinary(d); err == nil {
+			t.Errorf("Expected error for data %x, got %q", d, res)
+		}
+	}
+}
+
+func TestGob(t *testing.T) {
+	type release struct {
+		Version Version
+		Min     *Version
+	}
+	min := MustParse("1.0.0")
+	r := release{Version: MustParse("1.2.3-beta.1+build.5"), Min: &min}
+
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(r); err != nil {
+		t.Fatal(err)
+	}
+	var res release
+	if err := gob.NewDecoder(&buf).Decode(&res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Version.String() != "1.2.3-beta.1+build.5" || res.Min.String() != "1.0.0" {
+		t.Errorf("Gob decoded release not equal: got %q, %q", res.Version, res.Min)
+	}
+}
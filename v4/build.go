@@ -0,0 +1,136 @@
+package semver
+
+import (
+	"fmt"
+	"time"
+)
+
+// Keys used by SetBuildCommit and SetBuildTimestamp.
+const (
+	BuildKeyCommit    = "sha"
+	BuildKeyTimestamp = "ts"
+)
+
+// BuildTimestampFormat is the time layout used by SetBuildTimestamp.
+const BuildTimestampFormat = "20060102150405"
+
+// BuildPair is a key/value pair of build meta data.
+// The build meta data "sha.abc1234.ci.4567" consists of the pairs
+// sha=abc1234 and ci=4567.
+type BuildPair struct {
+	Key   string
+	Value string
+}
+
+// BuildPairs returns the build meta data of v as ordered key/value pairs.
+// An error is returned if the last key has no value.
+func (v Version) BuildPairs() ([]BuildPair, error) {
+	if len(v.Build)%2 != 0 {
+		return nil, fmt.Errorf("Build meta data has no value for key %q", v.Build[len(v.Build)-1])
+	}
+	pairs := make([]BuildPair, 0, len(v.Build)/2)
+	for i := 0; i < len(v.Build); i += 2 {
+		pairs = append(pairs, BuildPair{Key: v.Build[i], Value: v.Build[i+1]})
+	}
+	return pairs, nil
+}
+
+// SetBuildPairs replaces the build meta data of v by the given pairs.
+// An error is returned and v is left unchanged if a key or value is not
+// valid build meta data, see NewBuildVersion.
+func (v *Version) SetBuildPairs(pairs []BuildPair) error {
+	build := make([]string, 0, 2*len(pairs))
+	for _, p := range pairs {
+		if err := validateBuildPair(p.Key, p.Value); err != nil {
+			return err
+		}
+		build = append(build, p.Key, p.Value)
+	}
+	if len(build) == 0 {
+		build = nil
+	}
+	v.Build = build
+	return nil
+}
+
+// BuildValue returns the value of the first build meta data pair with the
+// given key. ok is false if there is none.
+func (v Version) BuildValue(key string) (value string, ok bool) {
+	for i := 0; i+1 < len(v.Build); i += 2 {
+		if v.Build[i] == key {
+			return v.Build[i+1], true
+		}
+	}
+	return "", false
+}
+
+// SetBuild sets the value of the build meta data pair with the given key,
+// appending a new pair if there is none.
+// An error is returned and v is left unchanged if key or value is not valid
+// build meta data, see NewBuildVersion, or the existing build meta data
+// does not consist of pairs.
+func (v *Version) SetBuild(key, value string) error {
+	if err := validateBuildPair(key, value); err != nil {
+		return err
+	}
+	if len(v.Build)%2 != 0 {
+		return fmt.Errorf("Build meta data has no value for key %q", v.Build[len(v.Build)-1])
+	}
+	build := make([]string, len(v.Build), len(v.Build)+2)
+	copy(build, v.Build)
+	for i := 0; i < len(build); i += 2 {
+		if build[i] == key {
+			build[i+1] = value
+			v.Build = build
+			return nil
+		}
+	}
+	v.Build = append(build, key, value)
+	return nil
+}
+
+// RemoveBuild removes all build meta data pairs with one of the given keys.
+// An error is returned and v is left unchanged if the build meta data does
+// not consist of pairs.
+func (v *Version) RemoveBuild(keys ...string) error {
+	if len(v.Build)%2 != 0 {
+		return fmt.Errorf("Build meta data has no value for key %q", v.Build[len(v.Build)-1])
+	}
+	var build []string
+	for i := 0; i < len(v.Build); i += 2 {
+		if !containsString(keys, v.Build[i]) {
+			build = append(build, v.Build[i], v.Build[i+1])
+		}
+	}
+	v.Build = build
+	return nil
+}
+
+// SetBuildCommit sets the commit SHA as build meta data, using BuildKeyCommit
+// as key: 1.2.3 becomes 1.2.3+sha.abc1234.
+func (v *Version) SetBuildCommit(sha string) error {
+	return v.SetBuild(BuildKeyCommit, sha)
+}
+
+// SetBuildTimestamp sets the UTC time t in BuildTimestampFormat as build
+// meta data, using BuildKeyTimestamp as key: 1.2.3 becomes 1.2.3+ts.20261017123456.
+func (v *Version) SetBuildTimestamp(t time.Time) error {
+	return v.SetBuild(BuildKeyTimestamp, t.UTC().Format(BuildTimestampFormat))
+}
+
+func validateBuildPair(key, value string) error {
+	if _, err := NewBuildVersion(key); err != nil {
+		return err
+	}
+	_, err := NewBuildVersion(value)
+	return err
+}
+
+func containsString(list []string, s string) bool {
+	for _, el := range list {
+		if el == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+package semver
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBuildPairs(t *testing.T) {
+	v := MustParse("1.2.3+git.abc1234.ts.20261017")
+	pairs, err := v.BuildPairs()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []BuildPair{{"git", "abc1234"}, {"ts", "20261017"}}
+	if !reflect.DeepEqual(pairs, expected) {
+		t.Errorf("Invalid pairs, expected %v, got %v", expected, pairs)
+	}
+	if value, ok := v.BuildValue("ts"); !ok || value != "20261017" {
+		t.Errorf("Invalid value for %q: %q, %t", "ts", value, ok)
+	}
+	if value, ok := v.BuildValue("abc1234"); ok {
+		t.Errorf("Expected no value for a value, got %q", value)
+	}
+
+	if err := v.SetBuildPairs([]BuildPair{{"ci", "4567"}, {"sha", "def"}}); err != nil {
+		t.Fatal(err)
+	}
+	if s := v.String(); s != "1.2.3+ci.4567.sha.def" {
+		t.Errorf("Invalid version after setting pairs: %q", s)
+	}
+	if err := v.SetBuildPairs([]BuildPair{{"ci", "45.67"}}); err == nil {
+		t.Errorf("Expected error for invalid value")
+	}
+	if s := v.String(); s != "1.2.3+ci.4567.sha.def" {
+		t.Errorf("Expected unchanged version, got %q", s)
+	}
+	if err := v.SetBuildPairs(nil); err != nil || v.Build != nil {
+		t.Errorf("Expected no build meta data, got %q, %v", v, err)
+	}
+
+	odd := MustParse("1.2.3+a.b.c")
+	if _, err := odd.BuildPairs(); err == nil {
+		t.Errorf("Expected error for odd build meta data")
+	}
+}
+
+func TestSetBuild(t *testing.T) {
+	orig := MustParse("1.2.3+git.abc1234.ts.20261017")
+	v := orig
+	if err := v.SetBuild("git", "def5678"); err != nil {
+		t.Fatal(err)
+	}
+	if err := v.SetBuild("ci", "4567"); err != nil {
+		t.Fatal(err)
+	}
+	if s := v.String(); s != "1.2.3+git.def5678.ts.20261017.ci.4567" {
+		t.Errorf("Invalid version after setting build: %q", s)
+	}
+	if s := orig.String(); s != "1.2.3+git.abc1234.ts.20261017" {
+		t.Errorf("Setting build modified the original version: %q", s)
+	}
+	if err := v.Validate(); err != nil {
+		t.Errorf("Validation failed: %q", err)
+	}
+
+	for _, p := range []BuildPair{{"", "x"}, {"x", ""}, {"a.b", "x"}, {"x", "a+b"}} {
+		if err := v.SetBuild(p.Key, p.Value); err == nil {
+			t.Errorf("Expected error for %+v", p)
+		}
+	}
+	odd := MustParse("1.2.3+a")
+	if err := odd.SetBuild("b", "c"); err == nil {
+		t.Errorf("Expected error for odd build meta data")
+	}
+}
+
+func TestRemoveBuild(t *testing.T) {
+	v := MustParse("1.2.3+git.abc1234.ts.20261017.ci.4567")
+	if err := v.RemoveBuild("ts", "ci", "missing"); err != nil {
+		t.Fatal(err)
+	}
+	if s := v.String(); s != "1.2.3+git.abc1234" {
+		t.Errorf("Invalid version after removing build: %q", s)
+	}
+	if err := v.RemoveBuild("git"); err != nil || v.Build != nil {
+		t.Errorf("Expected no build meta data, got %q, %v", v, err)
+	}
+	odd := MustParse("1.2.3+a")
+	if err := odd.RemoveBuild("a"); err == nil || odd.String() != "1.2.3+a" {
+		t.Errorf("Expected error and unchanged version, got %q, %v", odd, err)
+	}
+}
+
+func TestSetBuildCommitAndTimestamp(t *testing.T) {
+	v := MustParse("1.2.3")
+	if err := v.SetBuildCommit("abc1234"); err != nil {
+		t.Fatal(err)
+	}
+	ts := time.Date(2026, 10, 17, 14, 30, 5, 0, time.FixedZone("CEST", 2*60*60))
+	if err := v.SetBuildTimestamp(ts); err != nil {
+		t.Fatal(err)
+	}
+	if s := v.String(); s != "1.2.3+sha.abc1234.ts.20261017123005" {
+		t.Errorf("Invalid version: %q", s)
+	}
+	if err := v.SetBuildCommit("abc/123"); err == nil {
+		t.Errorf("Expected error for invalid commit")
+	}
+}
@@ -0,0 +1,76 @@
+package semver
+
+// ChannelOrder ranks alphanumeric prerelease identifiers like release
+// channels, from the least to the most stable one. Comparing versions by
+// a ChannelOrder instead of Compare orders ranked identifiers by their
+// position, while all other rules of the precedence stay the same.
+// Identifiers missing from the ChannelOrder come after the ranked ones
+// and are compared lexically. A ChannelOrder is a Comparator.
+//
+//	order := semver.ChannelOrder{"dev", "nightly", "alpha", "beta", "preview", "rc"}
+//	order.Compare(semver.MustParse("1.0.0-dev.3"), semver.MustParse("1.0.0-beta.1")) // returns -1
+type ChannelOrder []string
+
+// Compare compares Versions v to o using the ChannelOrder:
+// -1 == v is less than o
+// 0 == v is equal to o
+// 1 == v is greater than o
+func (c ChannelOrder) Compare(v, o Version) int {
+	if v.Major != o.Major || v.Minor != o.Minor || v.Patch != o.Patch || len(v.Pre) == 0 || len(o.Pre) == 0 {
+		return v.Compare(o)
+	}
+	i := 0
+	for ; i < len(v.Pre) && i < len(o.Pre); i++ {
+		if comp := c.comparePR(v.Pre[i], o.Pre[i]); comp != 0 {
+			return comp
+		}
+	}
+	if i == len(v.Pre) && i == len(o.Pre) {
+		return 0
+	} else if i == len(v.Pre) {
+		return -1
+	}
+	return 1
+}
+
+// comparePR compares two prerelease identifiers using the ChannelOrder.
+func (c ChannelOrder) comparePR(v, o PRVersion) int {
+	if v.IsNum || o.IsNum {
+		return v.Compare(o)
+	}
+	vi, oi := c.rank(v.VersionStr), c.rank(o.VersionStr)
+	if vi == oi {
+		return v.Compare(o)
+	} else if vi > oi {
+		return 1
+	}
+	return -1
+}
+
+// rank returns the position of the identifier within the ChannelOrder,
+// len(c) if it is not ranked.
+func (c ChannelOrder) rank(s string) int {
+	for i, id := range c {
+		if id == s {
+			return i
+		}
+	}
+	return len(c)
+}
+
+// Sort sorts a slice of versions using the ChannelOrder.
+func (c ChannelOrder) Sort(versions []Version) {
+	SortWith(versions, c)
+}
+
+// Max returns the greatest of the versions using the ChannelOrder.
+// ok is false if versions is empty.
+func (c ChannelOrder) Max(versions []Version) (Version, bool) {
+	return MaxWith(versions, c)
+}
+
+// ParseRange parses a range like ParseRange, but the returned Range
+// compares versions using the ChannelOrder.
+func (c ChannelOrder) ParseRange(s string) (Range, error) {
+	return ParseRangeWith(s, c)
+}
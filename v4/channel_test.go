@@ -0,0 +1,104 @@
+package semver
+
+import (
+	"testing"
+)
+
+var testChannels = ChannelOrder{"dev", "nightly", "alpha", "beta", "preview", "rc"}
+
+func TestChannelOrderCompare(t *testing.T) {
+	tests := []struct {
+		v1, v2 string
+		result int
+	}{
+		{"1.0.0-dev", "1.0.0-nightly", -1},
+		{"1.0.0-nightly", "1.0.0-alpha", -1},
+		{"1.0.0-preview", "1.0.0-rc", -1},
+		{"1.0.0-dev.5", "1.0.0-beta.1", -1},
+		{"1.0.0-beta.2", "1.0.0-beta.11", -1},
+		{"1.0.0-beta", "1.0.0-beta.1", -1},
+		{"1.0.0-rc.1", "1.0.0-rc.1", 0},
+		{"1.0.0-rc.1+b1", "1.0.0-rc.1+b2", 0},
+		{"1.0.0-rc", "1.0.0", -1},
+		{"1.0.0-1", "1.0.0-dev", -1},
+		{"1.0.0-rc", "1.0.0-custom", -1},
+		{"1.0.0-custom", "1.0.0-other", -1},
+		{"1.0.0-beta.dev", "1.0.0-beta.nightly", -1},
+		{"1.0.0-rc", "1.0.1-dev", -1},
+		{"1.0.0", "2.0.0", -1},
+	}
+	for _, tc := range tests {
+		v1, v2 := MustParse(tc.v1), MustParse(tc.v2)
+		if res := testChannels.Compare(v1, v2); res != tc.result {
+			t.Errorf("Comparing %q : %q, expected %d but got %d", tc.v1, tc.v2, tc.result, res)
+		}
+		if res := testChannels.Compare(v2, v1); res != -tc.result {
+			t.Errorf("Comparing %q : %q, expected %d but got %d", tc.v2, tc.v1, -tc.result, res)
+		}
+	}
+
+	// Without channels the precedence of the spec applies
+	for _, tc := range compareTests {
+		if res := (ChannelOrder{}).Compare(tc.v1, tc.v2); res != tc.result {
+			t.Errorf("Comparing %q : %q, expected %d but got %d", tc.v1, tc.v2, tc.result, res)
+		}
+	}
+}
+
+func TestChannelOrderSortAndMax(t *testing.T) {
+	versions := []Version{
+		MustParse("1.0.0-rc.1"),
+		MustParse("1.0.0-dev.2"),
+		MustParse("1.0.0"),
+		MustParse("1.0.0-preview"),
+		MustParse("1.0.0-nightly.20261017"),
+		MustParse("1.0.0-alpha.1"),
+	}
+	max, ok := testChannels.Max(versions[1:2])
+	if !ok || max.String() != "1.0.0-dev.2" {
+		t.Errorf("Invalid max: %q (%t)", max, ok)
+	}
+	max, ok = testChannels.Max(versions[:2])
+	if !ok || max.String() != "1.0.0-rc.1" {
+		t.Errorf("Invalid max: %q (%t)", max, ok)
+	}
+	if max, ok := testChannels.Max(nil); ok {
+		t.Errorf("Expected no max, got %q", max)
+	}
+
+	testChannels.Sort(versions)
+	correct := []string{"1.0.0-dev.2", "1.0.0-nightly.20261017", "1.0.0-alpha.1", "1.0.0-preview", "1.0.0-rc.1", "1.0.0"}
+	for i, v := range versions {
+		if v.String() != correct[i] {
+			t.Fatalf("Sort returned wrong order: %s", versions)
+		}
+	}
+}
+
+func TestChannelOrderParseRange(t *testing.T) {
+	r, err := testChannels.ParseRange(">=1.0.0-alpha <1.0.0 || 2.0.0-dev")
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		v string
+		b bool
+	}{
+		{"1.0.0-dev.1", false},
+		{"1.0.0-nightly.1", false},
+		{"1.0.0-alpha", true},
+		{"1.0.0-preview.1", true},
+		{"1.0.0-rc.2", true},
+		{"1.0.0", false},
+		{"2.0.0-dev", true},
+	}
+	for _, tc := range tests {
+		if res := r(MustParse(tc.v)); res != tc.b {
+			t.Errorf("Invalid for %q: Expected %t, got %t", tc.v, tc.b, res)
+		}
+	}
+
+	if _, err := testChannels.ParseRange(">=1.0.0 ||"); err == nil {
+		t.Errorf("Expected error for invalid range")
+	}
+}
@@ -0,0 +1,133 @@
+package semver
+
+import (
+	"errors"
+	"strconv"
+)
+
+// Match is a version found in text by the Extract functions.
+// Start and End are the byte offsets of the version-like substring,
+// so text[Start:End] is the part the version was built from.
+type Match struct {
+	Version Version
+	Start   int
+	End     int
+}
+
+// Coerce extracts the first version-like substring of s, like "1.21.6" in
+// "nginx/1.21.6 (Ubuntu)", and returns it as a Version.
+// See Extract for the rules.
+func Coerce(s string) (Version, error) {
+	m, ok := Extract(s)
+	if !ok {
+		return Version{}, errors.New("No version found")
+	}
+	return m.Version, nil
+}
+
+// Extract scans s for the first version-like substring.
+//
+// A version-like substring is made of one to three dot separated numbers,
+// optionally prefixed by "v" or "V". A number directly following a letter,
+// like in "x64", needs at least two components, like in "go1.21".
+// Missing minor and patch numbers are filled up with 0, leading zeroes
+// are ignored. Prerelease and build meta data can not be told apart from
+// surrounding text reliably and are not extracted:
+//
+//	"nginx/1.21.6 (Ubuntu)"           => 1.21.6
+//	"openssl-3.0.2-linux-x64.tar.gz"  => 3.0.2
+//	"Version: v2.14 build 1234"       => 2.14.0
+//	"go version go1.21.3 linux/amd64" => 1.21.3
+func Extract(s string) (Match, bool) {
+	m, _, ok := scanVersion(s, 0)
+	return m, ok
+}
+
+// ExtractLast scans s for the last version-like substring.
+// See Extract for the rules.
+func ExtractLast(s string) (Match, bool) {
+	var last Match
+	found := false
+	for i := 0; ; {
+		m, next, ok := scanVersion(s, i)
+		if !ok {
+			return last, found
+		}
+		last, found, i = m, true, next
+	}
+}
+
+// ExtractAll scans s for all version-like substrings.
+// See Extract for the rules.
+func ExtractAll(s string) []Match {
+	var res []Match
+	for i := 0; ; {
+		m, next, ok := scanVersion(s, i)
+		if !ok {
+			return res
+		}
+		res = append(res, m)
+		i = next
+	}
+}
+
+// scanVersion returns the first version-like substring of s starting at
+// byte offset i and the offset to continue scanning at.
+func scanVersion(s string, i int) (m Match, next int, ok bool) {
+	for i < len(s) {
+		if !isDigit(s[i]) || (i > 0 && isDigit(s[i-1])) {
+			i++
+			continue
+		}
+		start := i
+		if i > 0 && (s[i-1] == 'v' || s[i-1] == 'V') && (i == 1 || !isAlpha(s[i-2])) {
+			start--
+		}
+
+		var nums [3]uint64
+		n, end := 0, i
+		for n < 3 {
+			j := end
+			for j < len(s) && isDigit(s[j]) {
+				j++
+			}
+			num, err := strconv.ParseUint(s[end:j], 10, 64)
+			if err != nil {
+				break
+			}
+			nums[n] = num
+			n++
+			end = j
+			if end+1 >= len(s) || s[end] != '.' || !isDigit(s[end+1]) {
+				break
+			}
+			end++
+		}
+		if n == 0 || (n == 1 && start == i && i > 0 && isAlpha(s[i-1])) {
+			// Number out of range or part of a word, skip it
+			for i < len(s) && isDigit(s[i]) {
+				i++
+			}
+			continue
+		}
+		if s[end-1] == '.' {
+			end--
+		}
+
+		m = Match{
+			Version: Version{Major: nums[0], Minor: nums[1], Patch: nums[2]},
+			Start:   start,
+			End:     end,
+		}
+		return m, end, true
+	}
+	return Match{}, len(s), false
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isAlpha(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
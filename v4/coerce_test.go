@@ -0,0 +1,87 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestExtract(t *testing.T) {
+	tests := []struct {
+		s     string
+		v     string
+		start int
+		end   int
+	}{
+		{"1.2.3", "1.2.3", 0, 5},
+		{"nginx/1.21.6 (Ubuntu)", "1.21.6", 6, 12},
+		{"openssl-3.0.2-linux-x64.tar.gz", "3.0.2", 8, 13},
+		{"Version: v2.14 build 1234", "2.14.0", 9, 14},
+		{"go version go1.21.3 linux/amd64", "1.21.3", 13, 19},
+		{"dev1.2", "1.2.0", 3, 6},
+		{"Python 3", "3.0.0", 7, 8},
+		{"V4.01.002", "4.1.2", 0, 9},
+		{"1.2.3.4", "1.2.3", 0, 5},
+		{"release 2.", "2.0.0", 8, 9},
+		{"99999999999999999999 1.0", "1.0.0", 21, 24},
+		{"1.99999999999999999999", "1.0.0", 0, 1},
+		{"x64 v2", "2.0.0", 4, 6},
+		{"amd64 build2.1", "2.1.0", 11, 14},
+	}
+	for _, tc := range tests {
+		m, ok := Extract(tc.s)
+		if !ok {
+			t.Errorf("Expected match for %q", tc.s)
+			continue
+		}
+		if m.Version.String() != tc.v || m.Start != tc.start || m.End != tc.end {
+			t.Errorf("Invalid for case %q: Expected %q at [%d:%d], got %q at [%d:%d]", tc.s, tc.v, tc.start, tc.end, m.Version, m.Start, m.End)
+		}
+		if v, err := Coerce(tc.s); err != nil || v.String() != tc.v {
+			t.Errorf("Invalid Coerce for case %q: Expected %q, got %q (%v)", tc.s, tc.v, v, err)
+		}
+	}
+
+	for _, s := range []string{"", "no version here", "v", "x", "99999999999999999999", "linux-x64", "dev2"} {
+		if m, ok := Extract(s); ok {
+			t.Errorf("Expected no match for %q, got %q", s, m.Version)
+		}
+		if _, err := Coerce(s); err == nil {
+			t.Errorf("Expected Coerce error for %q", s)
+		}
+	}
+}
+
+func TestExtractAllAndLast(t *testing.T) {
+	const openssl = "openssl-3.0.2-linux-x64.tar.gz"
+	if all := ExtractAll(openssl); len(all) != 1 || all[0].Version.String() != "3.0.2" {
+		t.Errorf("Invalid matches for %q: %d", openssl, len(all))
+	}
+	if m, ok := ExtractLast(openssl); !ok || m.Version.String() != "3.0.2" {
+		t.Errorf("Invalid last match for %q: %q", openssl, m.Version)
+	}
+
+	s := "upgrade from v1.2.3 to 1.4 (build 1234)"
+	all := ExtractAll(s)
+	expected := []string{"1.2.3", "1.4.0", "1234.0.0"}
+	if len(all) != len(expected) {
+		t.Fatalf("Expected %d matches, got %d", len(expected), len(all))
+	}
+	for i, m := range all {
+		if m.Version.String() != expected[i] {
+			t.Errorf("Invalid match %d: Expected %q, got %q", i, expected[i], m.Version)
+		}
+	}
+	if sub := s[all[0].Start:all[0].End]; sub != "v1.2.3" {
+		t.Errorf("Invalid offsets: got %q", sub)
+	}
+
+	m, ok := ExtractLast(s)
+	if !ok || m.Version.String() != "1234.0.0" || s[m.Start:m.End] != "1234" {
+		t.Errorf("Invalid last match: %q at [%d:%d]", m.Version, m.Start, m.End)
+	}
+	if _, ok := ExtractLast("none"); ok {
+		t.Errorf("Expected no last match")
+	}
+	if all := ExtractAll("none"); len(all) != 0 {
+		t.Errorf("Expected no matches, got %d", len(all))
+	}
+}
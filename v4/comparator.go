@@ -0,0 +1,101 @@
+package semver
+
+import (
+	"sort"
+)
+
+// Comparator defines an ordering of versions. Compare returns
+// -1 if v is less than o, 0 if both are equal and 1 if v is greater than o.
+//
+// A Comparator can be used for sorting, ranges and selecting the greatest
+// or least version instead of the precedence defined by Version.Compare.
+type Comparator interface {
+	Compare(v, o Version) int
+}
+
+// ComparatorFunc is a function used as a Comparator.
+type ComparatorFunc func(v, o Version) int
+
+// Compare calls f(v, o).
+func (f ComparatorFunc) Compare(v, o Version) int {
+	return f(v, o)
+}
+
+var (
+	// Precedence orders versions by Version.Compare, the precedence defined
+	// by the spec.
+	Precedence Comparator = ComparatorFunc(Version.Compare)
+
+	// StrictPrecedence orders versions by Version.CompareStrict, which
+	// includes build meta data.
+	StrictPrecedence Comparator = ComparatorFunc(Version.CompareStrict)
+)
+
+// PreferStable returns a Comparator ordering every release above every
+// prerelease, so the greatest version is the latest stable one if there
+// is any. Versions which are both releases or both prereleases are ordered by c.
+func PreferStable(c Comparator) Comparator {
+	return ComparatorFunc(func(v, o Version) int {
+		if vs, os := len(v.Pre) == 0, len(o.Pre) == 0; vs && !os {
+			return 1
+		} else if !vs && os {
+			return -1
+		}
+		return c.Compare(v, o)
+	})
+}
+
+// versionsBy sorts Versions by a Comparator.
+type versionsBy struct {
+	Versions
+	c Comparator
+}
+
+// Less checks if version at index i is less than version at index j
+func (s versionsBy) Less(i, j int) bool {
+	return s.c.Compare(s.Versions[i], s.Versions[j]) < 0
+}
+
+// By returns a sort.Interface ordering the collection by c.
+func (s Versions) By(c Comparator) sort.Interface {
+	return versionsBy{Versions: s, c: c}
+}
+
+// SortWith sorts a slice of versions by c.
+func SortWith(versions []Version, c Comparator) {
+	sort.Sort(Versions(versions).By(c))
+}
+
+// MaxWith returns the greatest of the versions ordered by c.
+// The first one is returned if several are equal.
+// ok is false if versions is empty.
+func MaxWith(versions []Version, c Comparator) (max Version, ok bool) {
+	for i, v := range versions {
+		if i == 0 || c.Compare(v, max) > 0 {
+			max = v
+		}
+	}
+	return max, len(versions) > 0
+}
+
+// MinWith returns the least of the versions ordered by c.
+// The first one is returned if several are equal.
+// ok is false if versions is empty.
+func MinWith(versions []Version, c Comparator) (min Version, ok bool) {
+	for i, v := range versions {
+		if i == 0 || c.Compare(v, min) < 0 {
+			min = v
+		}
+	}
+	return min, len(versions) > 0
+}
+
+// ParseRangeWith parses a range like ParseRange, but the returned Range
+// compares versions by c.
+func ParseRangeWith(s string, c Comparator) (Range, error) {
+	r, err := ParseRangeSet(s)
+	if err != nil {
+		return nil, err
+	}
+	return r.RangeWith(c), nil
+}
@@ -0,0 +1,132 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestComparators(t *testing.T) {
+	a, b := MustParse("1.0.0+b1"), MustParse("1.0.0+b2")
+	if c := Precedence.Compare(a, b); c != 0 {
+		t.Errorf("Precedence: expected 0, got %d", c)
+	}
+	if c := StrictPrecedence.Compare(a, b); c != -1 {
+		t.Errorf("StrictPrecedence: expected -1, got %d", c)
+	}
+
+	stable := PreferStable(Precedence)
+	tests := []struct {
+		v1, v2 string
+		result int
+	}{
+		{"1.0.0", "2.0.0-rc.1", 1},
+		{"1.0.0-rc.1", "1.0.0-rc.2", -1},
+		{"1.0.0", "1.0.1", -1},
+		{"1.0.0", "1.0.0+build", 0},
+	}
+	for _, tc := range tests {
+		v1, v2 := MustParse(tc.v1), MustParse(tc.v2)
+		if res := stable.Compare(v1, v2); res != tc.result {
+			t.Errorf("Comparing %q : %q, expected %d but got %d", tc.v1, tc.v2, tc.result, res)
+		}
+		if res := stable.Compare(v2, v1); res != -tc.result {
+			t.Errorf("Comparing %q : %q, expected %d but got %d", tc.v2, tc.v1, -tc.result, res)
+		}
+	}
+}
+
+func TestSortWith(t *testing.T) {
+	versions := []Version{
+		MustParse("2.0.0-rc.1"),
+		MustParse("1.0.0"),
+		MustParse("1.1.0-beta"),
+		MustParse("1.0.1"),
+	}
+	SortWith(versions, PreferStable(Precedence))
+
+	correct := []string{"1.1.0-beta", "2.0.0-rc.1", "1.0.0", "1.0.1"}
+	for i, v := range versions {
+		if v.String() != correct[i] {
+			t.Fatalf("SortWith returned wrong order: %s", versions)
+		}
+	}
+
+	reverse := ComparatorFunc(func(v, o Version) int {
+		return o.Compare(v)
+	})
+	SortWith(versions, reverse)
+	correct = []string{"2.0.0-rc.1", "1.1.0-beta", "1.0.1", "1.0.0"}
+	for i, v := range versions {
+		if v.String() != correct[i] {
+			t.Fatalf("SortWith returned wrong order: %s", versions)
+		}
+	}
+}
+
+func TestMaxMinWith(t *testing.T) {
+	versions := []Version{
+		MustParse("1.0.0"),
+		MustParse("2.0.0-rc.1"),
+		MustParse("1.0.0+build"),
+		MustParse("0.9.0-beta"),
+	}
+	tests := []struct {
+		c        Comparator
+		max, min string
+	}{
+		{Precedence, "2.0.0-rc.1", "0.9.0-beta"},
+		{StrictPrecedence, "2.0.0-rc.1", "0.9.0-beta"},
+		{PreferStable(Precedence), "1.0.0", "0.9.0-beta"},
+		{PreferStable(StrictPrecedence), "1.0.0+build", "0.9.0-beta"},
+	}
+	for i, tc := range tests {
+		if max, ok := MaxWith(versions, tc.c); !ok || max.String() != tc.max {
+			t.Errorf("Invalid max for case %d: Expected %q, got %q (%t)", i, tc.max, max, ok)
+		}
+		if min, ok := MinWith(versions, tc.c); !ok || min.String() != tc.min {
+			t.Errorf("Invalid min for case %d: Expected %q, got %q (%t)", i, tc.min, min, ok)
+		}
+	}
+	if _, ok := MaxWith(nil, Precedence); ok {
+		t.Errorf("Expected no max for empty versions")
+	}
+	if _, ok := MinWith(nil, Precedence); ok {
+		t.Errorf("Expected no min for empty versions")
+	}
+}
+
+func TestParseRangeWith(t *testing.T) {
+	r, err := ParseRangeWith("<=1.0.0+b5", StrictPrecedence)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		v string
+		b bool
+	}{
+		{"1.0.0", true},
+		{"1.0.0+b4", true},
+		{"1.0.0+b5", true},
+		{"1.0.0+b6", false},
+		{"1.0.1", false},
+	}
+	for _, tc := range tests {
+		if res := r(MustParse(tc.v)); res != tc.b {
+			t.Errorf("Invalid for %q: Expected %t, got %t", tc.v, tc.b, res)
+		}
+	}
+
+	rs := MustParseRangeSet(">1.0.0 <2.0.0")
+	for _, c := range []Comparator{Precedence, testChannels} {
+		r := rs.RangeWith(c)
+		for _, vs := range []string{"1.0.0", "1.5.0", "2.0.0-rc.1", "2.0.0"} {
+			v := MustParse(vs)
+			if r(v) != rs.Contains(v) {
+				t.Errorf("Mismatch for %q: RangeWith %t, Contains %t", vs, r(v), rs.Contains(v))
+			}
+		}
+	}
+
+	if _, err := ParseRangeWith("foo", Precedence); err == nil {
+		t.Errorf("Expected error for invalid range")
+	}
+}
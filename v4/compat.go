@@ -0,0 +1,72 @@
+package semver
+
+// CaretRange returns the range of versions compatible with v following
+// semver's rules, like npm's "^" operator: changes to the left-most non-zero
+// component are breaking, so within 0.x a minor bump is breaking and within
+// 0.0.x a patch bump is.
+//
+//	^1.2.3  becomes  >=1.2.3 <2.0.0-0
+//	^0.2.3  becomes  >=0.2.3 <0.3.0-0
+//	^0.0.3  becomes  >=0.0.3 <0.0.4-0
+//
+// The upper bound excludes prereleases of the next breaking version.
+func (v Version) CaretRange() RangeSet {
+	switch {
+	case v.Major > 0:
+		return v.SameMajor()
+	case v.Minor > 0:
+		return v.SameMinor()
+	}
+	return v.compatRange(ComponentPatch)
+}
+
+// TildeRange returns the range of versions with the same major and minor
+// version as v, which are at least v, like npm's "~" operator.
+//
+//	~1.2.3  becomes  >=1.2.3 <1.3.0-0
+func (v Version) TildeRange() RangeSet {
+	return v.SameMinor()
+}
+
+// SameMajor returns the range of versions with the same major version as v,
+// which are at least v.
+//
+//	1.2.3  becomes  >=1.2.3 <2.0.0-0
+func (v Version) SameMajor() RangeSet {
+	return v.compatRange(ComponentMajor)
+}
+
+// SameMinor returns the range of versions with the same major and minor
+// version as v, which are at least v.
+//
+//	1.2.3  becomes  >=1.2.3 <1.3.0-0
+func (v Version) SameMinor() RangeSet {
+	return v.compatRange(ComponentMinor)
+}
+
+// CompatibleWith checks if o can replace v without breaking changes,
+// meaning o satisfies v.CaretRange().
+func (v Version) CompatibleWith(o Version) bool {
+	return v.CaretRange().Contains(o)
+}
+
+// compatRange returns the range from v up to, but excluding, the first
+// prerelease of the next version incrementing component c. An overflow
+// carries into the next higher component, only if the major version
+// overflows the range is unbounded.
+func (v Version) compatRange(c Component) RangeSet {
+	lo := Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch, Pre: v.Pre}
+	var next Version
+	switch {
+	case c == ComponentPatch && v.Patch+1 != 0:
+		next = Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1}
+	case c != ComponentMajor && v.Minor+1 != 0:
+		next = Version{Major: v.Major, Minor: v.Minor + 1}
+	case v.Major+1 != 0:
+		next = Version{Major: v.Major + 1}
+	default:
+		return AtLeast(lo)
+	}
+	next.Pre = minPrerelease()
+	return Between(lo, next, true, false)
+}
@@ -0,0 +1,78 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestCompatRanges(t *testing.T) {
+	tests := []struct {
+		v         string
+		caret     string
+		tilde     string
+		sameMajor string
+		sameMinor string
+	}{
+		{"1.2.3", ">=1.2.3 <2.0.0-0", ">=1.2.3 <1.3.0-0", ">=1.2.3 <2.0.0-0", ">=1.2.3 <1.3.0-0"},
+		{"0.2.3", ">=0.2.3 <0.3.0-0", ">=0.2.3 <0.3.0-0", ">=0.2.3 <1.0.0-0", ">=0.2.3 <0.3.0-0"},
+		{"0.0.3", ">=0.0.3 <0.0.4-0", ">=0.0.3 <0.1.0-0", ">=0.0.3 <1.0.0-0", ">=0.0.3 <0.1.0-0"},
+		{"1.2.3-beta.2+build.5", ">=1.2.3-beta.2 <2.0.0-0", ">=1.2.3-beta.2 <1.3.0-0", ">=1.2.3-beta.2 <2.0.0-0", ">=1.2.3-beta.2 <1.3.0-0"},
+		{"18446744073709551615.0.0", ">=18446744073709551615.0.0", ">=18446744073709551615.0.0 <18446744073709551615.1.0-0", ">=18446744073709551615.0.0", ">=18446744073709551615.0.0 <18446744073709551615.1.0-0"},
+		{"0.0.18446744073709551615", ">=0.0.18446744073709551615 <0.1.0-0", ">=0.0.18446744073709551615 <0.1.0-0", ">=0.0.18446744073709551615 <1.0.0-0", ">=0.0.18446744073709551615 <0.1.0-0"},
+		{"0.18446744073709551615.18446744073709551615", ">=0.18446744073709551615.18446744073709551615 <1.0.0-0", ">=0.18446744073709551615.18446744073709551615 <1.0.0-0", ">=0.18446744073709551615.18446744073709551615 <1.0.0-0", ">=0.18446744073709551615.18446744073709551615 <1.0.0-0"},
+		{"1.18446744073709551615.0", ">=1.18446744073709551615.0 <2.0.0-0", ">=1.18446744073709551615.0 <2.0.0-0", ">=1.18446744073709551615.0 <2.0.0-0", ">=1.18446744073709551615.0 <2.0.0-0"},
+		{"18446744073709551615.18446744073709551615.0", ">=18446744073709551615.18446744073709551615.0", ">=18446744073709551615.18446744073709551615.0", ">=18446744073709551615.18446744073709551615.0", ">=18446744073709551615.18446744073709551615.0"},
+	}
+	for _, tc := range tests {
+		v := MustParse(tc.v)
+		if s := v.CaretRange().String(); s != tc.caret {
+			t.Errorf("Invalid caret range for %q: Expected %q, got: %q", tc.v, tc.caret, s)
+		}
+		if s := v.TildeRange().String(); s != tc.tilde {
+			t.Errorf("Invalid tilde range for %q: Expected %q, got: %q", tc.v, tc.tilde, s)
+		}
+		if s := v.SameMajor().String(); s != tc.sameMajor {
+			t.Errorf("Invalid major range for %q: Expected %q, got: %q", tc.v, tc.sameMajor, s)
+		}
+		if s := v.SameMinor().String(); s != tc.sameMinor {
+			t.Errorf("Invalid minor range for %q: Expected %q, got: %q", tc.v, tc.sameMinor, s)
+		}
+	}
+}
+
+func TestCompatRangeDoesNotAlias(t *testing.T) {
+	r := MustParse("1.2.3").CaretRange()
+	r.alts[0][1].v.Pre[0] = PRVersion{VersionStr: "beta"}
+	if s := MustParse("1.0.0").CaretRange().String(); s != ">=1.0.0 <2.0.0-0" {
+		t.Errorf("Invalid caret range after modifying a previous one: %q", s)
+	}
+	if s := (RangeSet{}).String(); s != "<0.0.0-0" {
+		t.Errorf("Invalid empty range after modifying a caret range: %q", s)
+	}
+}
+
+func TestCompatibleWith(t *testing.T) {
+	tests := []struct {
+		v string
+		o string
+		b bool
+	}{
+		{"1.2.3", "1.2.3", true},
+		{"1.2.3", "1.9.0", true},
+		{"1.2.3", "1.2.2", false},
+		{"1.2.3", "2.0.0", false},
+		{"1.2.3", "2.0.0-rc.1", false},
+		{"0.2.3", "0.2.9", true},
+		{"0.2.3", "0.3.0", false},
+		{"0.0.3", "0.0.4", false},
+		{"0.0.3", "0.0.3+build", true},
+		{"1.2.3-beta", "1.2.3", true},
+		{"0.0.18446744073709551615", "5.0.0", false},
+		{"0.0.18446744073709551615", "0.1.0", false},
+		{"1.18446744073709551615.0", "2.0.0", false},
+	}
+	for _, tc := range tests {
+		if b := MustParse(tc.v).CompatibleWith(MustParse(tc.o)); b != tc.b {
+			t.Errorf("Invalid for %q compatible with %q: Expected %t, got: %t", tc.v, tc.o, tc.b, b)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package semver
+
+import (
+	"strings"
+)
+
+// ConventionalCommit is a commit message following the Conventional Commits
+// specification, like "feat(parser)!: support ranges".
+type ConventionalCommit struct {
+	Type        string // lower case type like "feat" or "fix"
+	Scope       string
+	Breaking    bool // marked by "!" in the header or a BREAKING CHANGE footer
+	Description string
+}
+
+// ParseConventionalCommit parses the header and footers of a commit message.
+// ok is false if the header does not follow the Conventional Commits format.
+func ParseConventionalCommit(message string) (c ConventionalCommit, ok bool) {
+	header := message
+	body := ""
+	if i := strings.IndexByte(message, '\n'); i != -1 {
+		header, body = message[:i], message[i+1:]
+	}
+	header = strings.TrimSpace(header)
+
+	colon := strings.Index(header, ": ")
+	if colon == -1 {
+		return ConventionalCommit{}, false
+	}
+	prefix := header[:colon]
+	c.Description = strings.TrimSpace(header[colon+2:])
+	if strings.HasSuffix(prefix, "!") {
+		c.Breaking = true
+		prefix = prefix[:len(prefix)-1]
+	}
+	if i := strings.IndexByte(prefix, '('); i != -1 {
+		if !strings.HasSuffix(prefix, ")") {
+			return ConventionalCommit{}, false
+		}
+		c.Scope = prefix[i+1 : len(prefix)-1]
+		prefix = prefix[:i]
+	}
+	if len(prefix) == 0 || !containsOnly(prefix, alphanum) || len(c.Description) == 0 {
+		return ConventionalCommit{}, false
+	}
+	c.Type = strings.ToLower(prefix)
+
+	for _, line := range strings.Split(body, "\n") {
+		if strings.HasPrefix(line, "BREAKING CHANGE:") || strings.HasPrefix(line, "BREAKING-CHANGE:") {
+			c.Breaking = true
+		}
+	}
+	return c, true
+}
+
+// Bump returns the component a commit requires to increment:
+// ComponentMajor for breaking changes, ComponentMinor for features,
+// ComponentPatch for fixes and ComponentNone otherwise.
+func (c ConventionalCommit) Bump() Component {
+	switch {
+	case c.Breaking:
+		return ComponentMajor
+	case c.Type == "feat":
+		return ComponentMinor
+	case c.Type == "fix":
+		return ComponentPatch
+	}
+	return ComponentNone
+}
+
+// BumpPolicy configures how NextVersion derives the next version.
+// The zero value follows the Conventional Commits defaults.
+type BumpPolicy struct {
+	// MajorInitialDevelopment bumps the major version for breaking changes
+	// within 0.x. By default they only bump the minor version.
+	MajorInitialDevelopment bool
+	// Channel releases the next version as prerelease of the channel,
+	// like "rc" for 1.3.0-rc.0. Consecutive prereleases of the same version
+	// increment the prerelease number, like 1.3.0-rc.1.
+	Channel string
+}
+
+// NextVersion returns the version to release after last, given the commit
+// messages since then. Messages not following the Conventional Commits
+// format are ignored. If no commit requires a release, last is returned.
+//
+// If last is a prerelease, it is finalized by the release of the same
+// version as long as the commits require no larger increment,
+// see Version.NextPatch.
+func NextVersion(last Version, messages []string, policy BumpPolicy) (Version, error) {
+	bump := ComponentNone
+	for _, m := range messages {
+		if c, ok := ParseConventionalCommit(m); ok && c.Bump() > bump {
+			bump = c.Bump()
+		}
+	}
+	if bump == ComponentMajor && last.Major == 0 && !policy.MajorInitialDevelopment {
+		bump = ComponentMinor
+	}
+	if bump == ComponentNone {
+		return last, nil
+	}
+
+	if len(policy.Channel) > 0 && len(last.Pre) > 0 && bump <= prereleaseBump(last) {
+		n := Version{Major: last.Major, Minor: last.Minor, Patch: last.Patch, Pre: last.Pre}
+		err := n.IncrementPrerelease(policy.Channel)
+		return n, err
+	}
+
+	var n Version
+	var err error
+	switch bump {
+	case ComponentMajor:
+		n, err = last.NextMajor()
+	case ComponentMinor:
+		n, err = last.NextMinor()
+	default:
+		n, err = last.NextPatch()
+	}
+	if err != nil || len(policy.Channel) == 0 {
+		return n, err
+	}
+	n.Pre, err = prereleaseIDs(policy.Channel, 0)
+	return n, err
+}
+
+// prereleaseBump returns the largest increment the release of prerelease v
+// already contains, like ComponentMinor for 1.3.0-rc.1.
+func prereleaseBump(v Version) Component {
+	switch {
+	case v.Minor == 0 && v.Patch == 0:
+		return ComponentMajor
+	case v.Patch == 0:
+		return ComponentMinor
+	}
+	return ComponentPatch
+}
@@ -0,0 +1,84 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestParseConventionalCommit(t *testing.T) {
+	tests := []struct {
+		message string
+		commit  ConventionalCommit
+		ok      bool
+	}{
+		{"feat: add ranges", ConventionalCommit{Type: "feat", Description: "add ranges"}, true},
+		{"Fix(parser): handle v prefix\n\nlonger body", ConventionalCommit{Type: "fix", Scope: "parser", Description: "handle v prefix"}, true},
+		{"feat!: drop Go 1.13", ConventionalCommit{Type: "feat", Breaking: true, Description: "drop Go 1.13"}, true},
+		{"refactor(api)!: rename Range", ConventionalCommit{Type: "refactor", Scope: "api", Breaking: true, Description: "rename Range"}, true},
+		{"chore: update deps\n\nBREAKING CHANGE: requires Go 1.14", ConventionalCommit{Type: "chore", Breaking: true, Description: "update deps"}, true},
+		{"fix: typo\n\nBREAKING-CHANGE: none really", ConventionalCommit{Type: "fix", Breaking: true, Description: "typo"}, true},
+		{"fix: typo\n\nnot a BREAKING CHANGE: footer", ConventionalCommit{Type: "fix", Description: "typo"}, true},
+		{"Merge branch 'master'", ConventionalCommit{}, false},
+		{"feat:missing space", ConventionalCommit{}, false},
+		{"feat: ", ConventionalCommit{}, false},
+		{": no type", ConventionalCommit{}, false},
+		{"feat(scope: unclosed", ConventionalCommit{}, false},
+		{"fe at: space in type", ConventionalCommit{}, false},
+	}
+	for _, tc := range tests {
+		c, ok := ParseConventionalCommit(tc.message)
+		if ok != tc.ok || c != tc.commit {
+			t.Errorf("Invalid for %q: Expected %+v (%t), got %+v (%t)", tc.message, tc.commit, tc.ok, c, ok)
+		}
+	}
+}
+
+func TestNextVersion(t *testing.T) {
+	tests := []struct {
+		last     string
+		messages []string
+		policy   BumpPolicy
+		expected string
+	}{
+		{"1.2.3", nil, BumpPolicy{}, "1.2.3"},
+		{"1.2.3", []string{"docs: readme", "chore: deps", "not conventional"}, BumpPolicy{}, "1.2.3"},
+		{"1.2.3", []string{"fix: a", "docs: b"}, BumpPolicy{}, "1.2.4"},
+		{"1.2.3", []string{"fix: a", "feat: b"}, BumpPolicy{}, "1.3.0"},
+		{"1.2.3", []string{"fix: a", "feat!: b", "feat: c"}, BumpPolicy{}, "2.0.0"},
+		{"1.2.3", []string{"fix: a\n\nBREAKING CHANGE: b"}, BumpPolicy{}, "2.0.0"},
+		{"0.2.3", []string{"feat!: b"}, BumpPolicy{}, "0.3.0"},
+		{"0.2.3", []string{"feat!: b"}, BumpPolicy{MajorInitialDevelopment: true}, "1.0.0"},
+		{"0.2.3", []string{"fix: b"}, BumpPolicy{}, "0.2.4"},
+		{"1.3.0-rc.1", []string{"fix: a"}, BumpPolicy{}, "1.3.0"},
+		{"1.3.0-rc.1", []string{"feat!: a"}, BumpPolicy{}, "2.0.0"},
+		{"1.2.3", []string{"feat: a"}, BumpPolicy{Channel: "rc"}, "1.3.0-rc.0"},
+		{"1.3.0-rc.0", []string{"fix: a"}, BumpPolicy{Channel: "rc"}, "1.3.0-rc.1"},
+		{"1.3.0-rc.0", []string{"feat: a"}, BumpPolicy{Channel: "rc"}, "1.3.0-rc.1"},
+		{"1.3.0-beta.4", []string{"feat: a"}, BumpPolicy{Channel: "rc"}, "1.3.0-rc.0"},
+		{"1.2.4-rc.0", []string{"feat: a"}, BumpPolicy{Channel: "rc"}, "1.3.0-rc.0"},
+		{"1.3.0-rc.0", []string{"feat!: a"}, BumpPolicy{Channel: "rc"}, "2.0.0-rc.0"},
+		{"2.0.0-rc.0", []string{"feat!: a"}, BumpPolicy{Channel: "rc"}, "2.0.0-rc.1"},
+		{"1.3.0-rc.0+build", []string{"docs: a"}, BumpPolicy{Channel: "rc"}, "1.3.0-rc.0+build"},
+	}
+	for _, tc := range tests {
+		n, err := NextVersion(MustParse(tc.last), tc.messages, tc.policy)
+		if err != nil {
+			t.Errorf("Next version of %q with %q, not expecting error, got %q", tc.last, tc.messages, err)
+		} else if n.String() != tc.expected {
+			t.Errorf("Next version of %q with %q, expecting %q, got %q", tc.last, tc.messages, tc.expected, n)
+		}
+	}
+
+	errorTests := []struct {
+		last   string
+		policy BumpPolicy
+	}{
+		{"2.0.0-rc.0", BumpPolicy{Channel: "beta"}},
+		{"1.2.3", BumpPolicy{Channel: "r c"}},
+		{"18446744073709551615.0.0", BumpPolicy{MajorInitialDevelopment: true}},
+	}
+	for _, tc := range errorTests {
+		if n, err := NextVersion(MustParse(tc.last), []string{"fix!: a"}, tc.policy); err == nil {
+			t.Errorf("Next version of %q with %+v, expecting error, got %q", tc.last, tc.policy, n)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package semver
+
+// Component identifies a part of a version.
+// Components are ordered by significance, ComponentMajor being the most significant.
+type Component int
+
+// Components of a version.
+const (
+	ComponentNone Component = iota
+	ComponentBuild
+	ComponentPrerelease
+	ComponentPatch
+	ComponentMinor
+	ComponentMajor
+)
+
+// String returns the lower case name of the component.
+func (c Component) String() string {
+	switch c {
+	case ComponentBuild:
+		return "build"
+	case ComponentPrerelease:
+		return "prerelease"
+	case ComponentPatch:
+		return "patch"
+	case ComponentMinor:
+		return "minor"
+	case ComponentMajor:
+		return "major"
+	}
+	return "none"
+}
+
+// Change describes the change between two versions, see Diff.
+type Change struct {
+	// Component is the most significant component differing between the versions.
+	Component Component
+	// Direction is 1 for an upgrade, -1 for a downgrade and 0 if both versions
+	// have the same precedence, like Compare of the new to the old version.
+	Direction int
+	// Breaking is true if the new version is not compatible with the old one
+	// by the rules of Version.CompatibleWith: any change of the major version,
+	// of the minor version within 0.x, of the patch version within 0.0.x,
+	// and every downgrade to a lower precedence is breaking.
+	Breaking bool
+}
+
+// Diff classifies the change from version a to version b.
+//
+//	Diff(MustParse("1.2.3"), MustParse("1.3.0")) // Change{ComponentMinor, 1, false}
+//	Diff(MustParse("0.2.3"), MustParse("0.3.0")) // Change{ComponentMinor, 1, true}
+func Diff(a, b Version) Change {
+	c := Change{Direction: b.Compare(a)}
+	switch {
+	case a.Major != b.Major:
+		c.Component = ComponentMajor
+	case a.Minor != b.Minor:
+		c.Component = ComponentMinor
+	case a.Patch != b.Patch:
+		c.Component = ComponentPatch
+	case c.Direction != 0:
+		c.Component = ComponentPrerelease
+	case !equalBuild(a.Build, b.Build):
+		c.Component = ComponentBuild
+	}
+	c.Breaking = c.Component > ComponentBuild && !a.CompatibleWith(b)
+	return c
+}
+
+// equalBuild checks if two lists of build meta data are equal.
+func equalBuild(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,60 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		a, b      string
+		component Component
+		direction int
+		breaking  bool
+	}{
+		{"1.2.3", "1.2.3", ComponentNone, 0, false},
+		{"1.2.3+build.1", "1.2.3+build.1", ComponentNone, 0, false},
+		{"1.2.3+build.1", "1.2.3+build.2", ComponentBuild, 0, false},
+		{"1.2.3", "1.2.3+build.1", ComponentBuild, 0, false},
+		{"1.2.3-rc.1", "1.2.3-rc.2", ComponentPrerelease, 1, false},
+		{"1.2.3-rc.1", "1.2.3", ComponentPrerelease, 1, false},
+		{"1.2.3", "1.2.3-rc.1", ComponentPrerelease, -1, true},
+		{"1.2.3", "1.2.4", ComponentPatch, 1, false},
+		{"1.2.4", "1.2.3", ComponentPatch, -1, true},
+		{"1.2.3", "1.3.0", ComponentMinor, 1, false},
+		{"1.2.3", "1.3.0-rc.1", ComponentMinor, 1, false},
+		{"1.2.3", "2.0.0", ComponentMajor, 1, true},
+		{"1.2.3", "2.0.0-rc.1", ComponentMajor, 1, true},
+		{"2.0.0", "1.9.0", ComponentMajor, -1, true},
+		{"0.2.3", "0.2.4", ComponentPatch, 1, false},
+		{"0.2.3", "0.3.0", ComponentMinor, 1, true},
+		{"0.0.3", "0.0.4", ComponentPatch, 1, true},
+		{"0.9.0", "1.0.0", ComponentMajor, 1, true},
+		{"0.0.18446744073709551615", "5.0.0", ComponentMajor, 1, true},
+		{"0.0.18446744073709551615", "0.1.0", ComponentMinor, 1, true},
+		{"1.18446744073709551615.0", "2.0.0", ComponentMajor, 1, true},
+	}
+	for _, tc := range tests {
+		c := Diff(MustParse(tc.a), MustParse(tc.b))
+		expected := Change{Component: tc.component, Direction: tc.direction, Breaking: tc.breaking}
+		if c != expected {
+			t.Errorf("Invalid diff of %q to %q: Expected %+v, got %+v", tc.a, tc.b, expected, c)
+		}
+	}
+}
+
+func TestComponentString(t *testing.T) {
+	names := map[Component]string{
+		ComponentNone:       "none",
+		ComponentBuild:      "build",
+		ComponentPrerelease: "prerelease",
+		ComponentPatch:      "patch",
+		ComponentMinor:      "minor",
+		ComponentMajor:      "major",
+		Component(42):       "none",
+	}
+	for c, name := range names {
+		if s := c.String(); s != name {
+			t.Errorf("Invalid name of component %d: Expected %q, got %q", int(c), name, s)
+		}
+	}
+}
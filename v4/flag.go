@@ -0,0 +1,140 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// VersionFlag is a flag.Value holding a Version parsed by Parse.
+// Besides flag.Value it implements the Type method of pflag's Value interface.
+//
+//	var min semver.VersionFlag
+//	flag.Var(&min, "min-version", "minimum supported version")
+type VersionFlag struct {
+	Version Version
+}
+
+// String returns the version string.
+func (f *VersionFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.Version.String()
+}
+
+// Set parses s and stores the Version.
+func (f *VersionFlag) Set(s string) error {
+	v, err := Parse(s)
+	if err != nil {
+		return fmt.Errorf("Expected version like \"1.2.3\": %s", err)
+	}
+	f.Version = v
+	return nil
+}
+
+// Type returns the name of the flag's type.
+func (f *VersionFlag) Type() string {
+	return "version"
+}
+
+// TolerantVersionFlag is like VersionFlag but parses the version using
+// ParseTolerant.
+type TolerantVersionFlag struct {
+	Version Version
+}
+
+// String returns the version string.
+func (f *TolerantVersionFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.Version.String()
+}
+
+// Set parses s and stores the Version.
+func (f *TolerantVersionFlag) Set(s string) error {
+	v, err := ParseTolerant(s)
+	if err != nil {
+		return fmt.Errorf("Expected version like \"1.2.3\" or \"v1.2\": %s", err)
+	}
+	f.Version = v
+	return nil
+}
+
+// Type returns the name of the flag's type.
+func (f *TolerantVersionFlag) Type() string {
+	return "version"
+}
+
+// RangeFlag is a flag.Value holding a range parsed by ParseRangeSet.
+// Besides flag.Value it implements the Type method of pflag's Value interface.
+//
+//	var compat semver.RangeFlag
+//	flag.Var(&compat, "compat", "range of compatible versions")
+//	compat.RangeSet.Contains(v)
+type RangeFlag struct {
+	RangeSet RangeSet
+}
+
+// String returns the canonical text of the range.
+func (f *RangeFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	return f.RangeSet.String()
+}
+
+// Set parses s and stores the RangeSet.
+func (f *RangeFlag) Set(s string) error {
+	r, err := ParseRangeSet(s)
+	if err != nil {
+		return fmt.Errorf("Expected range like \">=1.0.0 <2.0.0\": %s", err)
+	}
+	f.RangeSet = r
+	return nil
+}
+
+// Type returns the name of the flag's type.
+func (f *RangeFlag) Type() string {
+	return "range"
+}
+
+// VersionsFlag is a flag.Value collecting a list of versions.
+// The flag can be repeated and each value may hold multiple versions
+// separated by commas.
+// Besides flag.Value it implements the Type method of pflag's Value interface.
+type VersionsFlag struct {
+	Versions Versions
+}
+
+// String returns the versions separated by commas.
+func (f *VersionsFlag) String() string {
+	if f == nil {
+		return ""
+	}
+	parts := make([]string, len(f.Versions))
+	for i, v := range f.Versions {
+		parts[i] = v.String()
+	}
+	return strings.Join(parts, ",")
+}
+
+// Set parses the comma separated versions in s and appends them.
+// If any version is invalid, none is appended.
+func (f *VersionsFlag) Set(s string) error {
+	var vs Versions
+	for _, part := range strings.Split(s, ",") {
+		v, err := Parse(strings.TrimSpace(part))
+		if err != nil {
+			return fmt.Errorf("Expected comma separated versions like \"1.2.3,1.3.0\": %q: %s", part, err)
+		}
+		vs = append(vs, v)
+	}
+	f.Versions = append(f.Versions, vs...)
+	return nil
+}
+
+// Type returns the name of the flag's type.
+func (f *VersionsFlag) Type() string {
+	return "versions"
+}
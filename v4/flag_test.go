@@ -0,0 +1,113 @@
+package semver
+
+import (
+	"flag"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+// pflagValue is the Value interface of github.com/spf13/pflag.
+type pflagValue interface {
+	String() string
+	Set(string) error
+	Type() string
+}
+
+var (
+	_ pflagValue = &VersionFlag{}
+	_ pflagValue = &TolerantVersionFlag{}
+	_ pflagValue = &RangeFlag{}
+	_ pflagValue = &VersionsFlag{}
+)
+
+func newTestFlagSet() *flag.FlagSet {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	return fs
+}
+
+func TestVersionFlag(t *testing.T) {
+	var min VersionFlag
+	var tolerant TolerantVersionFlag
+	var compat RangeFlag
+	fs := newTestFlagSet()
+	fs.Var(&min, "min-version", "")
+	fs.Var(&tolerant, "tolerant", "")
+	fs.Var(&compat, "compat", "")
+
+	err := fs.Parse([]string{"-min-version", "1.2.3-rc.1", "-tolerant", "v1.2", "-compat", ">=1.0.0  <2.0.0 || 3.x"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := min.Version.String(); s != "1.2.3-rc.1" {
+		t.Errorf("Invalid version, expected %q, got %q", "1.2.3-rc.1", s)
+	}
+	if s := tolerant.Version.String(); s != "1.2.0" {
+		t.Errorf("Invalid tolerant version, expected %q, got %q", "1.2.0", s)
+	}
+	if s := compat.String(); s != ">=1.0.0 <2.0.0 || >=3.0.0 <4.0.0" {
+		t.Errorf("Invalid range, got %q", s)
+	}
+	if !compat.RangeSet.Contains(MustParse("3.1.0")) || compat.RangeSet.Contains(MustParse("2.0.0")) {
+		t.Errorf("Invalid range matching for %q", compat.String())
+	}
+}
+
+func TestFlagErrors(t *testing.T) {
+	tests := []struct {
+		v   flag.Value
+		s   string
+		err string
+	}{
+		{&VersionFlag{}, "v1.2.3", `Expected version like "1.2.3"`},
+		{&TolerantVersionFlag{}, "foo", `Expected version like "1.2.3" or "v1.2"`},
+		{&RangeFlag{}, ">>1.0.0", `Expected range like ">=1.0.0 <2.0.0"`},
+		{&VersionsFlag{}, "1.0.0,1.0", `Expected comma separated versions like "1.2.3,1.3.0": "1.0"`},
+	}
+	for _, tc := range tests {
+		fs := newTestFlagSet()
+		fs.Var(tc.v, "f", "")
+		err := fs.Parse([]string{"-f", tc.s})
+		if err == nil {
+			t.Errorf("Expected error for %q", tc.s)
+		} else if !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("Invalid error for %q, expected %q in %q", tc.s, tc.err, err)
+		}
+	}
+}
+
+func TestFlagNilString(t *testing.T) {
+	for _, v := range []flag.Value{(*VersionFlag)(nil), (*TolerantVersionFlag)(nil), (*RangeFlag)(nil), (*VersionsFlag)(nil)} {
+		if s := v.String(); s != "" {
+			t.Errorf("Invalid string of nil %T: %q", v, s)
+		}
+	}
+
+	fs := newTestFlagSet()
+	fs.Var(&VersionFlag{}, "version", "")
+	fs.Var(&RangeFlag{}, "range", "")
+	fs.Var(&VersionsFlag{}, "versions", "")
+	fs.PrintDefaults()
+}
+
+func TestVersionsFlag(t *testing.T) {
+	var vs VersionsFlag
+	fs := newTestFlagSet()
+	fs.Var(&vs, "v", "")
+	if err := fs.Parse([]string{"-v", "1.0.0", "-v", "1.1.0, 2.0.0-rc.1"}); err != nil {
+		t.Fatal(err)
+	}
+	if s := vs.String(); s != "1.0.0,1.1.0,2.0.0-rc.1" {
+		t.Errorf("Invalid versions, got %q", s)
+	}
+	if err := vs.Set("3.0.0,x"); err == nil {
+		t.Errorf("Expected error")
+	}
+	if len(vs.Versions) != 3 {
+		t.Errorf("Expected no versions appended on error, got %q", vs.String())
+	}
+	if s := (&VersionsFlag{}).String(); s != "" {
+		t.Errorf("Expected empty string, got %q", s)
+	}
+}
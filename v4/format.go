@@ -0,0 +1,139 @@
+package semver
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Format implements the fmt.Formatter interface.
+//
+// The verbs %s, %q, %x and %X format the version string like for any
+// other string, %v prints the version like String. A precision with %v
+// limits the printed components:
+//
+//	%.1v  major only, e.g. "1"
+//	%.2v  major and minor, e.g. "1.2"
+//	%.3v  major, minor and patch, e.g. "1.2.3"
+//	%.4v  without build meta data, e.g. "1.2.3-rc.1"
+//
+// Width and the '-' flag pad the result. %#v prints the Go syntax
+// representation of the struct, other verbs format its fields.
+func (v Version) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('#') {
+			fmt.Fprintf(f, "semver.Version{Major:%#v, Minor:%#v, Patch:%#v, Pre:%#v, Build:%#v}", v.Major, v.Minor, v.Patch, v.Pre, v.Build)
+			return
+		}
+	case 's', 'q', 'x', 'X':
+		fmt.Fprintf(f, formatDirective(f, verb, true), v.String())
+		return
+	default:
+		// Format the fields without calling Format again
+		type fields Version
+		fmt.Fprintf(f, formatDirective(f, verb, true), fields(v))
+		return
+	}
+
+	s := v.String()
+	if p, ok := f.Precision(); ok {
+		switch p {
+		case 1:
+			s = strconv.FormatUint(v.Major, 10)
+		case 2:
+			s = strconv.FormatUint(v.Major, 10) + "." + strconv.FormatUint(v.Minor, 10)
+		case 3:
+			s = v.FinalizeVersion()
+		case 4:
+			s = Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch, Pre: v.Pre}.String()
+		}
+	}
+	fmt.Fprintf(f, formatDirective(f, 's', false), s)
+}
+
+// formatDirective rebuilds the directive Format was called for, with verb
+// and optionally without the precision.
+func formatDirective(f fmt.State, verb rune, precision bool) string {
+	d := []byte{'%'}
+	for _, flag := range "+-# 0" {
+		if f.Flag(int(flag)) {
+			d = append(d, byte(flag))
+		}
+	}
+	if w, ok := f.Width(); ok {
+		d = strconv.AppendInt(d, int64(w), 10)
+	}
+	if p, ok := f.Precision(); ok && precision {
+		d = append(d, '.')
+		d = strconv.AppendInt(d, int64(p), 10)
+	}
+	return string(append(d, string(verb)...))
+}
+
+// Format returns v rendered by the layout, which consists of text and
+// the placeholders {major}, {minor}, {patch}, {pre} and {build}:
+//
+//	Format(v, "{major}.{minor}")               // returns "1.2"
+//	Format(v, "v{major}.{minor}.{patch}-{pre}") // returns "v1.2.3-rc.1"
+//
+// A placeholder may start with a separator, which is only written if the
+// component is not empty, so "{major}.{minor}.{patch}{-pre}{+build}" is
+// equal to String. "{{" and "}}" are written as "{" and "}".
+// An error is returned if the layout contains an unknown or unclosed
+// placeholder.
+func Format(v Version, layout string) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(layout); i++ {
+		c := layout[i]
+		if c == '}' {
+			if i+1 < len(layout) && layout[i+1] == '}' {
+				i++
+			}
+			b.WriteByte(c)
+			continue
+		}
+		if c != '{' {
+			b.WriteByte(c)
+			continue
+		}
+		if i+1 < len(layout) && layout[i+1] == '{' {
+			b.WriteByte(c)
+			i++
+			continue
+		}
+		end := strings.IndexByte(layout[i:], '}')
+		if end < 0 {
+			return "", fmt.Errorf("Unclosed placeholder in layout %q", layout)
+		}
+		placeholder := layout[i+1 : i+end]
+		name := strings.TrimLeft(placeholder, "-+._~")
+		sep := placeholder[:len(placeholder)-len(name)]
+		var value string
+		switch name {
+		case "major":
+			value = strconv.FormatUint(v.Major, 10)
+		case "minor":
+			value = strconv.FormatUint(v.Minor, 10)
+		case "patch":
+			value = strconv.FormatUint(v.Patch, 10)
+		case "pre":
+			for j, pre := range v.Pre {
+				if j > 0 {
+					value += "."
+				}
+				value += pre.String()
+			}
+		case "build":
+			value = strings.Join(v.Build, ".")
+		default:
+			return "", fmt.Errorf("Unknown placeholder %q in layout %q", "{"+placeholder+"}", layout)
+		}
+		if value != "" {
+			b.WriteString(sep)
+			b.WriteString(value)
+		}
+		i += end
+	}
+	return b.String(), nil
+}
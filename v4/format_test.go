@@ -0,0 +1,77 @@
+package semver
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFormatter(t *testing.T) {
+	v := MustParse("1.2.3-rc.1+build.5")
+	tests := []struct {
+		format string
+		result string
+	}{
+		{"%s", "1.2.3-rc.1+build.5"},
+		{"%v", "1.2.3-rc.1+build.5"},
+		{"%+v", "1.2.3-rc.1+build.5"},
+		{"%q", `"1.2.3-rc.1+build.5"`},
+		{"%.1v", "1"},
+		{"%.2v", "1.2"},
+		{"%.3v", "1.2.3"},
+		{"%.4v", "1.2.3-rc.1"},
+		{"%.9v", "1.2.3-rc.1+build.5"},
+		{"%8.2v|", "     1.2|"},
+		{"%-8.2v|", "1.2     |"},
+		{"%.3s", "1.2"},
+		{"%.4q", `"1.2."`},
+		{"%22s|", "    1.2.3-rc.1+build.5|"},
+		{"%-8.3s|", "1.2     |"},
+		{"%x", "312e322e332d72632e312b6275696c642e35"},
+		{"%.3X", "312E32"},
+		{"% x", "31 2e 32 2e 33 2d 72 63 2e 31 2b 62 75 69 6c 64 2e 35"},
+		{"%d", `{1 2 3 [{%!d(string=rc) 0 %!d(bool=false)} {%!d(string=) 1 %!d(bool=true)}] [%!d(string=build) %!d(string=5)]}`},
+		{"%#v", `semver.Version{Major:0x1, Minor:0x2, Patch:0x3, Pre:[]semver.PRVersion{semver.PRVersion{VersionStr:"rc", VersionNum:0x0, IsNum:false}, semver.PRVersion{VersionStr:"", VersionNum:0x1, IsNum:true}}, Build:[]string{"build", "5"}}`},
+	}
+	for _, tc := range tests {
+		if s := fmt.Sprintf(tc.format, v); s != tc.result {
+			t.Errorf("Invalid for format %q: Expected %q, got: %q", tc.format, tc.result, s)
+		}
+	}
+
+	vs := Versions{MustParse("1.0.0"), MustParse("2.1.0-beta")}
+	if s := fmt.Sprintf("%.2v", vs); s != "[1.0 2.1]" {
+		t.Errorf("Invalid for Versions: %q", s)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		v      string
+		layout string
+		result string
+	}{
+		{"1.2.3-rc.1+build.5", "{major}.{minor}", "1.2"},
+		{"1.2.3-rc.1+build.5", "v{major}.{minor}.{patch}-{pre}", "v1.2.3-rc.1"},
+		{"1.2.3", "{major}.{minor}.{patch}-{pre}", "1.2.3-"},
+		{"1.2.3", "{major}.{minor}.{patch}{-pre}{+build}", "1.2.3"},
+		{"1.2.3-rc.1+build.5", "{major}.{minor}.{patch}{-pre}{+build}", "1.2.3-rc.1+build.5"},
+		{"1.2.3-rc.1+build.5", "{major}.{minor}.{patch}{-pre}{_build}", "1.2.3-rc.1_build.5"},
+		{"1.2.3", "{{major}} is {major}", "{major} is 1"},
+		{"1.2.3", "release-{major}-{minor}", "release-1-2"},
+		{"1.2.3", "", ""},
+	}
+	for _, tc := range tests {
+		s, err := Format(MustParse(tc.v), tc.layout)
+		if err != nil {
+			t.Errorf("Unexpected error for %q with layout %q: %q", tc.v, tc.layout, err)
+		} else if s != tc.result {
+			t.Errorf("Invalid for %q with layout %q: Expected %q, got: %q", tc.v, tc.layout, tc.result, s)
+		}
+	}
+
+	for _, layout := range []string{"{major", "{mayor}", "{}", "{-}", "{major}.{minor"} {
+		if _, err := Format(MustParse("1.2.3"), layout); err == nil {
+			t.Errorf("Expected error for layout %q", layout)
+		}
+	}
+}
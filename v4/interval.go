@@ -0,0 +1,349 @@
+package semver
+
+import (
+	"hash/fnv"
+	"sort"
+	"strings"
+)
+
+// bound is one end of an interval.
+type bound struct {
+	v         Version
+	inclusive bool
+	unbounded bool
+}
+
+// interval is a contiguous range of versions between two bounds.
+type interval struct {
+	lo, hi bound
+}
+
+var (
+	unboundedInterval = interval{lo: bound{unbounded: true}, hi: bound{unbounded: true}}
+
+	// minVersion is the smallest possible version, no version precedes it.
+	// It is only read, values handed out use minPrerelease instead.
+	minVersion = Version{Pre: []PRVersion{{VersionNum: 0, IsNum: true}}}
+)
+
+// belowLo checks if v lies below the lower bound b.
+func belowLo(b bound, v Version) bool {
+	if b.unbounded {
+		return false
+	}
+	c := v.Compare(b.v)
+	return c < 0 || (c == 0 && !b.inclusive)
+}
+
+// aboveHi checks if v lies above the upper bound b.
+func aboveHi(b bound, v Version) bool {
+	if b.unbounded {
+		return false
+	}
+	c := v.Compare(b.v)
+	return c > 0 || (c == 0 && !b.inclusive)
+}
+
+// compareLo orders two lower bounds, the bound admitting more versions
+// comes first.
+func compareLo(a, b bound) int {
+	switch {
+	case a.unbounded && b.unbounded:
+		return 0
+	case a.unbounded:
+		return -1
+	case b.unbounded:
+		return 1
+	}
+	if c := a.v.Compare(b.v); c != 0 {
+		return c
+	}
+	if a.inclusive == b.inclusive {
+		return 0
+	} else if a.inclusive {
+		return -1
+	}
+	return 1
+}
+
+// compareHi orders two upper bounds, the bound admitting more versions
+// comes last.
+func compareHi(a, b bound) int {
+	switch {
+	case a.unbounded && b.unbounded:
+		return 0
+	case a.unbounded:
+		return 1
+	case b.unbounded:
+		return -1
+	}
+	if c := a.v.Compare(b.v); c != 0 {
+		return c
+	}
+	if a.inclusive == b.inclusive {
+		return 0
+	} else if a.inclusive {
+		return 1
+	}
+	return -1
+}
+
+// empty checks if no version lies within the interval.
+func (iv interval) empty() bool {
+	if iv.lo.unbounded || iv.hi.unbounded {
+		return false
+	}
+	c := iv.lo.v.Compare(iv.hi.v)
+	return c > 0 || (c == 0 && !(iv.lo.inclusive && iv.hi.inclusive))
+}
+
+// touches checks if the interval o, which must not start before iv,
+// overlaps or directly follows iv, so both can be merged.
+func (iv interval) touches(o interval) bool {
+	if iv.hi.unbounded || o.lo.unbounded {
+		return true
+	}
+	c := o.lo.v.Compare(iv.hi.v)
+	return c < 0 || (c == 0 && (o.lo.inclusive || iv.hi.inclusive))
+}
+
+func (iv interval) String() string {
+	switch {
+	case iv.lo.unbounded && iv.hi.unbounded:
+		return ">=" + minVersion.String()
+	case iv.lo.unbounded:
+		return upperString(iv.hi)
+	case iv.hi.unbounded:
+		return lowerString(iv.lo)
+	case iv.lo.inclusive && iv.hi.inclusive && iv.lo.v.Compare(iv.hi.v) == 0:
+		return iv.lo.v.String()
+	}
+	return lowerString(iv.lo) + " " + upperString(iv.hi)
+}
+
+func lowerString(b bound) string {
+	if b.inclusive {
+		return ">=" + b.v.String()
+	}
+	return ">" + b.v.String()
+}
+
+func upperString(b bound) string {
+	if b.inclusive {
+		return "<=" + b.v.String()
+	}
+	return "<" + b.v.String()
+}
+
+// intervals returns the sorted, disjoint intervals satisfying the term.
+func (t rangeTerm) intervals() []interval {
+	switch t.op {
+	case opEQ:
+		return []interval{{lo: bound{v: t.v, inclusive: true}, hi: bound{v: t.v, inclusive: true}}}
+	case opNE:
+		return []interval{
+			{lo: bound{unbounded: true}, hi: bound{v: t.v}},
+			{lo: bound{v: t.v}, hi: bound{unbounded: true}},
+		}
+	case opGT:
+		return []interval{{lo: bound{v: t.v}, hi: bound{unbounded: true}}}
+	case opGE:
+		return []interval{{lo: bound{v: t.v, inclusive: true}, hi: bound{unbounded: true}}}
+	case opLT:
+		return []interval{{lo: bound{unbounded: true}, hi: bound{v: t.v}}}
+	case opLE:
+		return []interval{{lo: bound{unbounded: true}, hi: bound{v: t.v, inclusive: true}}}
+	}
+	return nil
+}
+
+// intersectIntervals intersects two sorted lists of disjoint intervals.
+func intersectIntervals(a, b []interval) []interval {
+	var res []interval
+	for _, x := range a {
+		for _, y := range b {
+			iv := x
+			if compareLo(y.lo, iv.lo) > 0 {
+				iv.lo = y.lo
+			}
+			if compareHi(y.hi, iv.hi) < 0 {
+				iv.hi = y.hi
+			}
+			if !iv.empty() {
+				res = append(res, iv)
+			}
+		}
+	}
+	return res
+}
+
+// unionIntervals sorts the intervals and merges the ones touching each other.
+func unionIntervals(ivs []interval) []interval {
+	sort.SliceStable(ivs, func(i, j int) bool {
+		return compareLo(ivs[i].lo, ivs[j].lo) < 0
+	})
+	var res []interval
+	for _, iv := range ivs {
+		if n := len(res); n > 0 && res[n-1].touches(iv) {
+			if compareHi(iv.hi, res[n-1].hi) > 0 {
+				res[n-1].hi = iv.hi
+			}
+			continue
+		}
+		res = append(res, iv)
+	}
+	return res
+}
+
+// CompiledRange is a range compiled into sorted, disjoint intervals.
+// Checking a version against it takes a binary search over the intervals
+// and does not allocate.
+type CompiledRange struct {
+	ivs []interval
+}
+
+// Compile compiles the RangeSet into a CompiledRange.
+func (r RangeSet) Compile() CompiledRange {
+	var all []interval
+	for _, alt := range r.alts {
+		ivs := []interval{unboundedInterval}
+		for _, t := range alt {
+			ivs = intersectIntervals(ivs, t.intervals())
+		}
+		all = append(all, ivs...)
+	}
+	return CompiledRange{ivs: unionIntervals(all)}
+}
+
+// Contains checks if v satisfies the CompiledRange.
+func (c CompiledRange) Contains(v Version) bool {
+	// Find the first interval not ending below v.
+	lo, hi := 0, len(c.ivs)
+	for lo < hi {
+		m := int(uint(lo+hi) >> 1)
+		if aboveHi(c.ivs[m].hi, v) {
+			lo = m + 1
+		} else {
+			hi = m
+		}
+	}
+	return lo < len(c.ivs) && !belowLo(c.ivs[lo].lo, v)
+}
+
+// Range returns the CompiledRange as a Range.
+func (c CompiledRange) Range() Range {
+	return Range(c.Contains)
+}
+
+// FilterSorted appends all versions of sorted satisfying the CompiledRange
+// to dst and returns the extended slice. sorted must be sorted in ascending
+// order, e.g. by Sort. Each interval is located by binary search, so no
+// allocations happen if dst has enough capacity.
+func (c CompiledRange) FilterSorted(dst, sorted Versions) Versions {
+	start := 0
+	for _, iv := range c.ivs {
+		rest := sorted[start:]
+		i := sort.Search(len(rest), func(k int) bool {
+			return !belowLo(iv.lo, rest[k])
+		})
+		j := i + sort.Search(len(rest)-i, func(k int) bool {
+			return aboveHi(iv.hi, rest[i+k])
+		})
+		dst = append(dst, rest[i:j]...)
+		start += j
+		if start == len(sorted) {
+			break
+		}
+	}
+	return dst
+}
+
+// String returns the canonical text of the CompiledRange, which can be
+// parsed again by ParseRange and ParseRangeSet.
+func (c CompiledRange) String() string {
+	if len(c.ivs) == 0 {
+		return "<" + minVersion.String()
+	}
+	parts := make([]string, len(c.ivs))
+	for i, iv := range c.ivs {
+		parts[i] = iv.String()
+	}
+	return strings.Join(parts, " || ")
+}
+
+// normalize returns the intervals in their unique form: every lower bound
+// is inclusive, every upper bound exclusive or unbounded, build meta data
+// is dropped and intervals without a version in between are merged.
+// Two ranges match the same versions iff their normalized intervals are equal.
+func (c CompiledRange) normalize() []interval {
+	var res []interval
+	for _, iv := range c.ivs {
+		n := interval{lo: bound{v: Version{Pre: minPrerelease()}, inclusive: true}, hi: iv.hi}
+		if !iv.lo.unbounded {
+			n.lo.v = iv.lo.v
+			if !iv.lo.inclusive {
+				s, ok := iv.lo.v.Successor()
+				if !ok {
+					continue
+				}
+				n.lo.v = s
+			}
+		}
+		if !iv.hi.unbounded && iv.hi.inclusive {
+			if s, ok := iv.hi.v.Successor(); ok {
+				n.hi = bound{v: s}
+			} else {
+				n.hi = bound{unbounded: true}
+			}
+		}
+		n.lo.v = Version{Major: n.lo.v.Major, Minor: n.lo.v.Minor, Patch: n.lo.v.Patch, Pre: n.lo.v.Pre}
+		if !n.hi.unbounded {
+			n.hi.v = Version{Major: n.hi.v.Major, Minor: n.hi.v.Minor, Patch: n.hi.v.Patch, Pre: n.hi.v.Pre}
+			if n.lo.v.Compare(n.hi.v) >= 0 {
+				continue
+			}
+		}
+		if l := len(res); l > 0 && res[l-1].touches(n) {
+			res[l-1].hi = n.hi
+			continue
+		}
+		res = append(res, n)
+	}
+	return res
+}
+
+// Equivalent checks if a and b match exactly the same versions,
+// regardless of how they are written.
+//
+//	Equivalent(MustParseRangeSet(">=1.0.0 <2.0.0"), MustParseRangeSet("<2.0.0 >=1.0.0 || 1.5.0")) // returns true
+func Equivalent(a, b RangeSet) bool {
+	return a.Compile().Equivalent(b.Compile())
+}
+
+// Equivalent checks if c and o match exactly the same versions.
+func (c CompiledRange) Equivalent(o CompiledRange) bool {
+	a, b := c.normalize(), o.normalize()
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if compareLo(a[i].lo, b[i].lo) != 0 || compareHi(a[i].hi, b[i].hi) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Hash returns a hash of the versions matched by the RangeSet.
+// Equivalent ranges have the same hash. The hash is stable and may be persisted.
+func (r RangeSet) Hash() uint64 {
+	return r.Compile().Hash()
+}
+
+// Hash returns a hash of the versions matched by the CompiledRange.
+// Equivalent ranges have the same hash. The hash is stable and may be persisted.
+func (c CompiledRange) Hash() uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(CompiledRange{ivs: c.normalize()}.String()))
+	return h.Sum64()
+}
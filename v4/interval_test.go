@@ -0,0 +1,163 @@
+package semver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		r string
+		s string
+	}{
+		{">=1.0.0", ">=1.0.0"},
+		{"<1.0.0", "<1.0.0"},
+		{"1.0.0", "1.0.0"},
+		{"!=1.0.0", "<1.0.0 || >1.0.0"},
+		{">=1.0.0 <2.0.0", ">=1.0.0 <2.0.0"},
+		{"<2.0.0 >=1.0.0", ">=1.0.0 <2.0.0"},
+		{"<2.0.0 >=1.0.0 || 1.5.0", ">=1.0.0 <2.0.0"},
+		{">=1.0.0 <2.0.0 || >=2.0.0 <3.0.0", ">=1.0.0 <3.0.0"},
+		{">=1.0.0 <2.0.0 || >2.0.0 <3.0.0", ">=1.0.0 <2.0.0 || >2.0.0 <3.0.0"},
+		{">=1.0.0 <=2.0.0 || >2.0.0 <3.0.0", ">=1.0.0 <3.0.0"},
+		{">=3.0.0 || <1.0.0", "<1.0.0 || >=3.0.0"},
+		{">1.0.0 <3.0.0 !2.0.0", ">1.0.0 <2.0.0 || >2.0.0 <3.0.0"},
+		{">=1.0.0 <=1.0.0", "1.0.0"},
+		{">=2.0.0 <1.0.0", "<0.0.0-0"},
+		{">1.0.0 <1.0.0", "<0.0.0-0"},
+		{"<1.0.0 || >=1.0.0", ">=0.0.0-0"},
+		{"!=1.0.0 || 1.0.0", ">=0.0.0-0"},
+	}
+	for _, tc := range tests {
+		c := MustParseRangeSet(tc.r).Compile()
+		if s := c.String(); s != tc.s {
+			t.Errorf("Invalid for case %q: Expected %q, got: %q", tc.r, tc.s, s)
+		}
+	}
+
+	if s := (RangeSet{}).Compile().String(); s != "<0.0.0-0" {
+		t.Errorf("Invalid for empty RangeSet: %q", s)
+	}
+}
+
+func TestCompiledRangeContains(t *testing.T) {
+	ranges := []string{
+		">=1.0.0 <2.0.0 || >=3.0.1 <4.0.0 !=3.0.3 || >=5.0.0",
+		"<=1.2.x || 2.x !2.5.0",
+		"!=1.0.0-rc.1",
+		">2.0.0 <1.0.0 || 1.0.0 || 1.0.1",
+		">=1.0.0-alpha <1.0.0",
+	}
+	versions := []string{
+		"0.0.0-0", "0.9.0", "1.0.0-alpha", "1.0.0-rc.1", "1.0.0", "1.0.1", "1.2.5",
+		"1.3.0", "2.0.0", "2.5.0", "3.0.0", "3.0.1", "3.0.3", "3.9.9", "4.0.0", "5.0.0", "9.0.0",
+	}
+	for _, s := range ranges {
+		rs := MustParseRangeSet(s)
+		c := rs.Compile()
+		r := c.Range()
+		for _, vs := range versions {
+			v := MustParse(vs)
+			if exp := rs.Contains(v); c.Contains(v) != exp || r(v) != exp {
+				t.Errorf("Invalid for case %q matching %q: Expected %t, got: %t", s, vs, exp, c.Contains(v))
+			}
+		}
+	}
+}
+
+func TestCompiledRangeFilterSorted(t *testing.T) {
+	var versions Versions
+	for _, s := range []string{"0.9.0", "1.0.0", "1.5.0", "2.0.0", "3.0.1", "3.0.3", "3.5.0", "4.0.0", "5.0.0", "6.0.0"} {
+		versions = append(versions, MustParse(s))
+	}
+	tests := []struct {
+		r string
+		v []string
+	}{
+		{">=1.0.0 <2.0.0 || >=3.0.1 <4.0.0 !=3.0.3 || >=5.0.0", []string{"1.0.0", "1.5.0", "3.0.1", "3.5.0", "5.0.0", "6.0.0"}},
+		{">6.0.0", nil},
+		{"<0.9.0", nil},
+		{"<=0.9.0 || 2.0.0", []string{"0.9.0", "2.0.0"}},
+		{">=0.0.0", []string{"0.9.0", "1.0.0", "1.5.0", "2.0.0", "3.0.1", "3.0.3", "3.5.0", "4.0.0", "5.0.0", "6.0.0"}},
+	}
+	for _, tc := range tests {
+		c := MustParseRangeSet(tc.r).Compile()
+		var exp Versions
+		for _, s := range tc.v {
+			exp = append(exp, MustParse(s))
+		}
+		if res := c.FilterSorted(nil, versions); !reflect.DeepEqual(res, exp) {
+			t.Errorf("Invalid for case %q: Expected %s, got: %s", tc.r, exp, res)
+		}
+	}
+
+	if res := MustParseRangeSet(">=1.0.0").Compile().FilterSorted(nil, nil); len(res) != 0 {
+		t.Errorf("Expected no versions for empty input, got: %s", res)
+	}
+}
+
+func TestCompiledRangeAllocs(t *testing.T) {
+	c := MustParseRangeSet(">=1.0.0 <2.0.0 || >=3.0.1 <4.0.0 !=3.0.3 || >=5.0.0").Compile()
+	v := MustParse("3.0.2-rc.1")
+	versions := Versions{MustParse("1.0.0"), MustParse("3.0.2"), MustParse("5.0.0")}
+	dst := make(Versions, 0, len(versions))
+	if n := testing.AllocsPerRun(100, func() {
+		c.Contains(v)
+		dst = c.FilterSorted(dst[:0], versions)
+	}); n != 0 {
+		t.Errorf("Expected no allocations, got %v", n)
+	}
+}
+
+func TestEquivalent(t *testing.T) {
+	tests := []struct {
+		a, b string
+		eq   bool
+	}{
+		{">=1.0.0 <2.0.0", "<2.0.0 >=1.0.0 || 1.5.0", true},
+		{">=1.0.0 <2.0.0", ">=1.0.0 <=2.0.0", false},
+		{">1.0.0", ">=1.0.1-0", true},
+		{"<=1.0.0", "<1.0.1-0", true},
+		{">1.0.0 <1.0.1-0", ">2.0.0 <1.0.0", true},
+		{"<=1.0.0 || >=1.0.1-0", ">=0.0.0-0", true},
+		{"!=1.0.0", "<1.0.0 || >1.0.0", true},
+		{"!=1.0.0", "<1.0.0 || >1.0.0 || 1.0.0", false},
+		{"1.0.0", ">=1.0.0 <=1.0.0+build.5", true},
+		{"1.0.0+build.1", "1.0.0+build.2", true},
+		{"1.x", ">=1.0.0 <2.0.0", true},
+		{"1.x", ">=1.0.0 <2.0.0-0", false},
+		{">=1.0.0-rc.1", ">1.0.0-rc.0", false},
+		{"<=18446744073709551615.18446744073709551615.18446744073709551615", ">=0.0.0-0", true},
+	}
+	for _, tc := range tests {
+		a, errA := ParseRangeSet(tc.a)
+		b, errB := ParseRangeSet(tc.b)
+		if errA != nil || errB != nil {
+			if tc.eq {
+				t.Errorf("Unexpected error for case %q, %q: %v %v", tc.a, tc.b, errA, errB)
+			}
+			continue
+		}
+		if eq := Equivalent(a, b); eq != tc.eq {
+			t.Errorf("Invalid for case %q, %q: Expected %t, got %t", tc.a, tc.b, tc.eq, eq)
+		}
+		if eq := Equivalent(b, a); eq != tc.eq {
+			t.Errorf("Invalid for case %q, %q: Expected %t, got %t", tc.b, tc.a, tc.eq, eq)
+		}
+		if eq := a.Hash() == b.Hash(); eq != tc.eq {
+			t.Errorf("Invalid hash for case %q, %q: Expected equality %t, got %t", tc.a, tc.b, tc.eq, eq)
+		}
+	}
+}
+
+func TestHashStable(t *testing.T) {
+	if h := MustParseRangeSet(">=1.0.0 <2.0.0").Hash(); h != MustParseRangeSet(">=1.0.0 <2.0.0").Compile().Hash() {
+		t.Errorf("RangeSet and CompiledRange hashes differ")
+	}
+	if h := MustParseRangeSet(">=1.0.0 <2.0.0 || >3.0.0").Hash(); h != 5994885067035400953 {
+		t.Errorf("Hash changed, got %d", h)
+	}
+	if (RangeSet{}).Hash() != MustParseRangeSet(">1.0.0 <1.0.0").Hash() {
+		t.Errorf("Empty ranges should hash equally")
+	}
+}
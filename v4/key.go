@@ -0,0 +1,57 @@
+package semver
+
+import (
+	"strings"
+)
+
+// Key is a comparable, immutable representation of a Version's precedence.
+// Versions with equal precedence, like "1.0.0+build.1" and "1.0.0+build.2",
+// have equal keys, so a Key can be used as map key where a Version can not.
+type Key struct {
+	Major uint64
+	Minor uint64
+	Patch uint64
+	pre   string // prerelease versions joined by '.', empty if none
+}
+
+// Key returns the Key of v. Creating the Key of a version without
+// prerelease versions does not allocate.
+func (v Version) Key() Key {
+	k := Key{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+	switch len(v.Pre) {
+	case 0:
+	case 1:
+		k.pre = v.Pre[0].String()
+	default:
+		var b strings.Builder
+		for i, pre := range v.Pre {
+			if i > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(pre.String())
+		}
+		k.pre = b.String()
+	}
+	return k
+}
+
+// Version converts k back to a Version without build meta data.
+func (k Key) Version() Version {
+	v := Version{Major: k.Major, Minor: k.Minor, Patch: k.Patch}
+	if len(k.pre) > 0 {
+		v.Pre = make([]PRVersion, 0, strings.Count(k.pre, ".")+1)
+		for s, more := k.pre, true; more; {
+			var prstr string
+			prstr, s, more = cutDot(s)
+			// Prerelease versions were validated when creating the Key
+			pr, _ := newPRVersion(prstr, true)
+			v.Pre = append(v.Pre, pr)
+		}
+	}
+	return v
+}
+
+// String returns the version string of k.
+func (k Key) String() string {
+	return k.Version().String()
+}
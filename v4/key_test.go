@@ -0,0 +1,61 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		v string
+		s string
+	}{
+		{"1.2.3", "1.2.3"},
+		{"1.2.3+build.1", "1.2.3"},
+		{"1.2.3-alpha", "1.2.3-alpha"},
+		{"1.2.3-alpha.1.b-eta+build", "1.2.3-alpha.1.b-eta"},
+		{"0.0.0-0", "0.0.0-0"},
+	}
+	for _, tc := range tests {
+		v := MustParse(tc.v)
+		k := v.Key()
+		if s := k.String(); s != tc.s {
+			t.Errorf("Invalid key for %q: Expected %q, got %q", tc.v, tc.s, s)
+		}
+		if back := k.Version(); back.Compare(v) != 0 || back.String() != tc.s {
+			t.Errorf("Invalid round trip for %q: got %q", tc.v, back)
+		}
+	}
+
+	large, err := ParseWithOptions("1.0.0-20261017123456789012", ParseOptions{LargePrerelease: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back := large.Key().Version(); back.Compare(large) != 0 {
+		t.Errorf("Invalid round trip for %q: got %q", large, back)
+	}
+}
+
+func TestKeyMap(t *testing.T) {
+	m := map[Key]int{}
+	m[MustParse("1.0.0+build.1").Key()]++
+	m[MustParse("1.0.0+build.2").Key()]++
+	m[MustParse("1.0.0-rc.1").Key()]++
+	m[MustParse("1.0.0-rc.1+build").Key()]++
+	m[MustParse("1.0.0-rc.1.0").Key()]++
+	if len(m) != 3 {
+		t.Errorf("Expected 3 keys, got %d", len(m))
+	}
+	if m[MustParse("1.0.0").Key()] != 2 || m[MustParse("1.0.0-rc.1").Key()] != 2 {
+		t.Errorf("Invalid map contents: %v", m)
+	}
+}
+
+func TestKeyAllocs(t *testing.T) {
+	v := MustParse("1.2.3+build.1")
+	m := map[Key]bool{v.Key(): true}
+	if n := testing.AllocsPerRun(100, func() {
+		_ = m[v.Key()]
+	}); n != 0 {
+		t.Errorf("Expected no allocations, got %v", n)
+	}
+}
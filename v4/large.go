@@ -0,0 +1,52 @@
+package semver
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// LargeVersion is a Version whose JSON, text and SQL decoding accepts
+// numeric prerelease versions exceeding uint64, like ParseWithOptions with
+// LargePrerelease. Decoding a Version or Key is strict like Parse, so a
+// version holding such a prerelease version can only be decoded again as
+// LargeVersion. Encoding is the same as for Version.
+type LargeVersion struct {
+	Version
+}
+
+// parseLarge parses s like Parse, but accepts numeric prerelease versions
+// exceeding uint64.
+func parseLarge(s string) (Version, error) {
+	return ParseWithOptions(s, ParseOptions{LargePrerelease: true})
+}
+
+// UnmarshalJSON implements the encoding/json.Unmarshaler interface.
+func (v *LargeVersion) UnmarshalJSON(data []byte) error {
+	var versionString string
+	if err := json.Unmarshal(data, &versionString); err != nil {
+		return err
+	}
+	return v.UnmarshalText([]byte(versionString))
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *LargeVersion) UnmarshalText(data []byte) error {
+	res, err := parseLarge(string(data))
+	if err != nil {
+		return err
+	}
+	v.Version = res
+	return nil
+}
+
+// Scan implements the database/sql.Scanner interface.
+// Unlike Version.Scan, an invalid version is reported as error.
+func (v *LargeVersion) Scan(src interface{}) error {
+	switch src := src.(type) {
+	case string:
+		return v.UnmarshalText([]byte(src))
+	case []byte:
+		return v.UnmarshalText(src)
+	}
+	return fmt.Errorf("version.Scan: cannot convert %T to string", src)
+}
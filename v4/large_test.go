@@ -0,0 +1,62 @@
+package semver
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestLargeVersionDecoding(t *testing.T) {
+	const versionString = "1.0.0-20261017123456789012.rc+build"
+	v, err := ParseWithOptions(versionString, ParseOptions{LargePrerelease: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Decoding a Version or Key stays strict like Parse
+	var sv Version
+	if err := json.Unmarshal(data, &sv); err == nil {
+		t.Errorf("Expected JSON error for Version, got %q", sv)
+	}
+	if err := sv.UnmarshalText([]byte(versionString)); err == nil {
+		t.Errorf("Expected text error for Version, got %q", sv)
+	}
+	var k Key
+	if err := k.UnmarshalText([]byte(versionString)); err == nil {
+		t.Errorf("Expected text error for Key, got %q", k)
+	}
+
+	var jv LargeVersion
+	if err := json.Unmarshal(data, &jv); err != nil || !jv.StrictEquals(v) {
+		t.Errorf("Invalid JSON round trip: %q, %v", jv.Version, err)
+	}
+	if out, err := json.Marshal(jv); err != nil || string(out) != string(data) {
+		t.Errorf("Invalid JSON encoding: %s, %v", out, err)
+	}
+	var tv LargeVersion
+	if err := tv.UnmarshalText([]byte(versionString)); err != nil || !tv.StrictEquals(v) {
+		t.Errorf("Invalid text round trip: %q, %v", tv.Version, err)
+	}
+	if tv.Key() != v.Key() {
+		t.Errorf("Invalid key: %q", tv.Key())
+	}
+	for _, src := range []interface{}{versionString, []byte(versionString)} {
+		var qv LargeVersion
+		if err := qv.Scan(src); err != nil || !qv.StrictEquals(v) {
+			t.Errorf("Invalid scan of %T: %q, %v", src, qv.Version, err)
+		}
+	}
+
+	var lv LargeVersion
+	if err := lv.Scan(123); err == nil {
+		t.Errorf("Expected error scanning an int")
+	}
+	for _, s := range []string{"", "v1.0.0", "1.0", "1.0.0-01"} {
+		if err := lv.UnmarshalText([]byte(s)); err == nil {
+			t.Errorf("Expected error for %q, got %q", s, lv.Version)
+		}
+	}
+}
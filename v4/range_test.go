@@ -579,3 +579,55 @@ func BenchmarkRangeMatchComplex(b *testing.B) {
 		r(v)
 	}
 }
+
+func BenchmarkCompiledRangeMatchSimple(b *testing.B) {
+	const VERSION = ">1.0.0"
+	r := MustParseRangeSet(VERSION).Compile()
+	v := MustParse("2.0.0")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		r.Contains(v)
+	}
+}
+
+func BenchmarkCompiledRangeMatchAverage(b *testing.B) {
+	const VERSION = ">=1.0.0 <2.0.0"
+	r := MustParseRangeSet(VERSION).Compile()
+	v := MustParse("1.2.3")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		r.Contains(v)
+	}
+}
+
+func BenchmarkCompiledRangeMatchComplex(b *testing.B) {
+	const VERSION = ">=1.0.0 <2.0.0 || >=3.0.1 <4.0.0 !=3.0.3 || >=5.0.0"
+	r := MustParseRangeSet(VERSION).Compile()
+	v := MustParse("5.0.1")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		r.Contains(v)
+	}
+}
+
+func BenchmarkCompiledRangeFilterSorted(b *testing.B) {
+	const VERSION = ">=1.0.0 <2.0.0 || >=3.0.1 <4.0.0 !=3.0.3 || >=5.0.0"
+	r := MustParseRangeSet(VERSION).Compile()
+	var versions Versions
+	for major := uint64(0); major < 6; major++ {
+		for minor := uint64(0); minor < 10; minor++ {
+			for patch := uint64(0); patch < 10; patch++ {
+				versions = append(versions, Version{Major: major, Minor: minor, Patch: patch})
+			}
+		}
+	}
+	dst := make(Versions, 0, len(versions))
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		dst = r.FilterSorted(dst[:0], versions)
+	}
+}
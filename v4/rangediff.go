@@ -0,0 +1,88 @@
+package semver
+
+import (
+	"strconv"
+)
+
+// RangeDiff describes how changing a range from an old to a new one changes
+// the set of allowed versions.
+type RangeDiff struct {
+	// Added are the versions of the catalog only allowed by the new range.
+	Added Versions
+	// Removed are the versions of the catalog only allowed by the old range.
+	Removed Versions
+	// Summary describes the change symbolically, independent of the catalog,
+	// like "now allows 2.0.0 prereleases" or "no longer allows 1.x".
+	Summary []string
+}
+
+// DiffRanges compares the old range from and the new range to against a
+// catalog of known versions. Added and Removed keep the order of the catalog.
+func DiffRanges(from, to RangeSet, catalog Versions) RangeDiff {
+	o, n := from.Compile(), to.Compile()
+	var d RangeDiff
+	for _, v := range catalog {
+		inOld, inNew := o.Contains(v), n.Contains(v)
+		if inNew && !inOld {
+			d.Added = append(d.Added, v)
+		} else if inOld && !inNew {
+			d.Removed = append(d.Removed, v)
+		}
+	}
+	for _, iv := range intersectIntervals(n.ivs, complementIntervals(o.ivs)) {
+		if s, ok := describeInterval(iv); ok {
+			d.Summary = append(d.Summary, "now allows "+s)
+		}
+	}
+	for _, iv := range intersectIntervals(o.ivs, complementIntervals(n.ivs)) {
+		if s, ok := describeInterval(iv); ok {
+			d.Summary = append(d.Summary, "no longer allows "+s)
+		}
+	}
+	return d
+}
+
+// complementIntervals returns the intervals of all versions not contained
+// in the given sorted, disjoint intervals.
+func complementIntervals(ivs []interval) []interval {
+	var res []interval
+	lo := bound{unbounded: true}
+	for _, iv := range ivs {
+		if !iv.lo.unbounded {
+			res = append(res, interval{lo: lo, hi: bound{v: iv.lo.v, inclusive: !iv.lo.inclusive}})
+		}
+		if iv.hi.unbounded {
+			return res
+		}
+		lo = bound{v: iv.hi.v, inclusive: !iv.hi.inclusive}
+	}
+	return append(res, interval{lo: lo, hi: bound{unbounded: true}})
+}
+
+// describeInterval returns a short description of the versions within iv.
+// ok is false if no version lies within iv.
+func describeInterval(iv interval) (s string, ok bool) {
+	ns := CompiledRange{ivs: []interval{iv}}.normalize()
+	if len(ns) == 0 {
+		return "", false
+	}
+	if n := ns[0]; !n.hi.unbounded {
+		lo, hi := n.lo.v, n.hi.v
+		if next, _ := lo.Successor(); next.Compare(hi) == 0 {
+			return lo.String(), true
+		}
+		if len(lo.Pre) == 1 && lo.Pre[0].Compare(minVersion.Pre[0]) == 0 && len(hi.Pre) == 0 &&
+			lo.Major == hi.Major && lo.Minor == hi.Minor && lo.Patch == hi.Patch {
+			return hi.String() + " prereleases", true
+		}
+		if len(lo.Pre) == 0 && lo.Patch == 0 && len(hi.Pre) == 1 && hi.Pre[0].Compare(minVersion.Pre[0]) == 0 && hi.Patch == 0 {
+			if lo.Minor == 0 && hi.Minor == 0 && hi.Major == lo.Major+1 {
+				return strconv.FormatUint(lo.Major, 10) + ".x", true
+			}
+			if hi.Major == lo.Major && hi.Minor == lo.Minor+1 {
+				return strconv.FormatUint(lo.Major, 10) + "." + strconv.FormatUint(lo.Minor, 10) + ".x", true
+			}
+		}
+	}
+	return iv.String(), true
+}
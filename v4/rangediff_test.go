@@ -0,0 +1,49 @@
+package semver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDiffRanges(t *testing.T) {
+	var catalog Versions
+	for _, s := range []string{"0.9.0", "1.0.0", "1.5.0", "2.0.0-rc.1", "2.0.0", "2.1.0", "3.0.0"} {
+		catalog = append(catalog, MustParse(s))
+	}
+	tests := []struct {
+		from    string
+		to      string
+		added   []string
+		removed []string
+		summary []string
+	}{
+		{">=1.0.0 <2.0.0", "<2.0.0 >=1.0.0 || 1.5.0", nil, nil, nil},
+		{">=1.0.0 <2.0.0-0", ">=1.0.0 <2.0.0", []string{"2.0.0-rc.1"}, nil, []string{"now allows 2.0.0 prereleases"}},
+		{">=1.0.0 <2.0.0-0", ">=1.0.0 <3.0.0-0", []string{"2.0.0-rc.1", "2.0.0", "2.1.0"}, nil, []string{"now allows >=2.0.0-0 <3.0.0-0"}},
+		{">=1.0.0 <3.0.0-0", ">=2.0.0 <3.0.0-0", nil, []string{"1.0.0", "1.5.0", "2.0.0-rc.1"}, []string{"no longer allows >=1.0.0 <2.0.0"}},
+		{">=1.0.0", ">=1.0.0 !1.5.0", nil, []string{"1.5.0"}, []string{"no longer allows 1.5.0"}},
+		{">=2.0.0 <3.0.0-0", ">=1.0.0 <2.0.0-0 || >=2.1.0 <3.0.0-0 || 3.x", []string{"1.0.0", "1.5.0", "3.0.0"}, []string{"2.0.0"},
+			[]string{"now allows 1.x", "now allows >=3.0.0 <4.0.0", "no longer allows >=2.0.0 <2.1.0"}},
+		{"<0.9.0", "<0.10.0-0", []string{"0.9.0"}, nil, []string{"now allows 0.9.x"}},
+		{"<1.0.0", ">1.0.0 <1.0.1-0 || <1.0.0", nil, nil, nil},
+	}
+	for _, tc := range tests {
+		d := DiffRanges(MustParseRangeSet(tc.from), MustParseRangeSet(tc.to), catalog)
+		var added, removed []string
+		for _, v := range d.Added {
+			added = append(added, v.String())
+		}
+		for _, v := range d.Removed {
+			removed = append(removed, v.String())
+		}
+		if !reflect.DeepEqual(added, tc.added) {
+			t.Errorf("Invalid added for %q -> %q: Expected %q, got: %q", tc.from, tc.to, tc.added, added)
+		}
+		if !reflect.DeepEqual(removed, tc.removed) {
+			t.Errorf("Invalid removed for %q -> %q: Expected %q, got: %q", tc.from, tc.to, tc.removed, removed)
+		}
+		if !reflect.DeepEqual(d.Summary, tc.summary) {
+			t.Errorf("Invalid summary for %q -> %q: Expected %q, got: %q", tc.from, tc.to, tc.summary, d.Summary)
+		}
+	}
+}
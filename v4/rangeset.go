@@ -0,0 +1,281 @@
+package semver
+
+import (
+	"fmt"
+	"strings"
+)
+
+// rangeOp is the operator of a single range term.
+type rangeOp int
+
+const (
+	opEQ rangeOp = iota
+	opNE
+	opGT
+	opGE
+	opLT
+	opLE
+)
+
+// String returns the canonical textual form of the operator.
+// Equality is rendered without an operator, like ParseRange accepts it.
+func (o rangeOp) String() string {
+	switch o {
+	case opNE:
+		return "!="
+	case opGT:
+		return ">"
+	case opGE:
+		return ">="
+	case opLT:
+		return "<"
+	case opLE:
+		return "<="
+	}
+	return ""
+}
+
+// parseRangeOp parses the operator part of a range term.
+func parseRangeOp(s string) (rangeOp, bool) {
+	switch s {
+	case "", "=", "==":
+		return opEQ, true
+	case "!", "!=":
+		return opNE, true
+	case ">":
+		return opGT, true
+	case ">=":
+		return opGE, true
+	case "<":
+		return opLT, true
+	case "<=":
+		return opLE, true
+	}
+	return 0, false
+}
+
+// rangeTerm is a single operator and version pair like ">=1.0.0".
+type rangeTerm struct {
+	op rangeOp
+	v  Version
+}
+
+// match checks if v satisfies the term.
+func (t rangeTerm) match(v Version) bool {
+	return t.op.holds(v.Compare(t.v))
+}
+
+// holds checks if the operator is satisfied by c, the result of comparing
+// a version to the version of the term.
+func (o rangeOp) holds(c int) bool {
+	switch o {
+	case opEQ:
+		return c == 0
+	case opNE:
+		return c != 0
+	case opGT:
+		return c > 0
+	case opGE:
+		return c >= 0
+	case opLT:
+		return c < 0
+	case opLE:
+		return c <= 0
+	}
+	return false
+}
+
+func (t rangeTerm) String() string {
+	return t.op.String() + t.v.String()
+}
+
+// RangeSet is a range of versions which, unlike a Range, keeps its
+// structure and can be rendered back to text.
+//
+// A RangeSet is a list of alternatives linked by logical OR, each of them
+// a list of conditions linked by logical AND, the same form ParseRange
+// accepts. The zero value matches no version.
+//
+//	r := semver.AtLeast(semver.MustParse("1.0.0")).AND(semver.Below(semver.MustParse("2.0.0")))
+//	r.String() // returns ">=1.0.0 <2.0.0"
+type RangeSet struct {
+	alts [][]rangeTerm
+}
+
+// newRangeSet creates a RangeSet consisting of a single term.
+func newRangeSet(op rangeOp, v Version) RangeSet {
+	return RangeSet{alts: [][]rangeTerm{{{op: op, v: v}}}}
+}
+
+// AtLeast returns a RangeSet matching all versions greater than or equal to v.
+func AtLeast(v Version) RangeSet {
+	return newRangeSet(opGE, v)
+}
+
+// Below returns a RangeSet matching all versions less than v.
+func Below(v Version) RangeSet {
+	return newRangeSet(opLT, v)
+}
+
+// Between returns a RangeSet matching all versions between lo and hi.
+// loInclusive and hiInclusive control whether lo and hi match themselves.
+func Between(lo, hi Version, loInclusive, hiInclusive bool) RangeSet {
+	t := [2]rangeTerm{{op: opGT, v: lo}, {op: opLT, v: hi}}
+	if loInclusive {
+		t[0].op = opGE
+	}
+	if hiInclusive {
+		t[1].op = opLE
+	}
+	return RangeSet{alts: [][]rangeTerm{t[:]}}
+}
+
+// Exactly returns a RangeSet matching only versions equal to v.
+func Exactly(v Version) RangeSet {
+	return newRangeSet(opEQ, v)
+}
+
+// Except returns a RangeSet matching all versions not equal to v.
+func Except(v Version) RangeSet {
+	return newRangeSet(opNE, v)
+}
+
+// AND combines the RangeSet with another RangeSet using logical AND.
+func (r RangeSet) AND(o RangeSet) RangeSet {
+	var res RangeSet
+	for _, a := range r.alts {
+		for _, b := range o.alts {
+			alt := make([]rangeTerm, 0, len(a)+len(b))
+			alt = append(alt, a...)
+			alt = append(alt, b...)
+			res.alts = append(res.alts, alt)
+		}
+	}
+	return res
+}
+
+// OR combines the RangeSet with another RangeSet using logical OR.
+func (r RangeSet) OR(o RangeSet) RangeSet {
+	res := RangeSet{alts: make([][]rangeTerm, 0, len(r.alts)+len(o.alts))}
+	res.alts = append(res.alts, r.alts...)
+	res.alts = append(res.alts, o.alts...)
+	return res
+}
+
+// Contains checks if v satisfies the RangeSet.
+func (r RangeSet) Contains(v Version) bool {
+	for _, alt := range r.alts {
+		ok := true
+		for _, t := range alt {
+			if !t.match(v) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// Range returns the RangeSet as a Range.
+func (r RangeSet) Range() Range {
+	return Range(r.Contains)
+}
+
+// RangeWith returns the RangeSet as a Range comparing versions by c
+// instead of Version.Compare.
+func (r RangeSet) RangeWith(c Comparator) Range {
+	return Range(func(v Version) bool {
+		for _, alt := range r.alts {
+			ok := true
+			for _, t := range alt {
+				if !t.op.holds(c.Compare(v, t.v)) {
+					ok = false
+					break
+				}
+			}
+			if ok {
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// String returns the canonical text of the RangeSet, which can be parsed
+// again by ParseRange and ParseRangeSet. A RangeSet matching no version is
+// rendered as "<0.0.0-0", like by CompiledRange.String.
+func (r RangeSet) String() string {
+	if len(r.alts) == 0 {
+		return "<" + minVersion.String()
+	}
+	var b strings.Builder
+	for i, alt := range r.alts {
+		if i > 0 {
+			b.WriteString(" || ")
+		}
+		for j, t := range alt {
+			if j > 0 {
+				b.WriteByte(' ')
+			}
+			b.WriteString(t.String())
+		}
+	}
+	return b.String()
+}
+
+// ParseRangeSet parses a range like ParseRange but returns a RangeSet.
+// If the range could not be parsed an error is returned.
+func ParseRangeSet(s string) (RangeSet, error) {
+	parts := splitAndTrim(s)
+	orParts, err := splitORParts(parts)
+	if err != nil {
+		return RangeSet{}, err
+	}
+	expandedParts, err := expandWildcardVersion(orParts)
+	if err != nil {
+		return RangeSet{}, err
+	}
+	var r RangeSet
+	for _, p := range expandedParts {
+		alt := make([]rangeTerm, 0, len(p))
+		for _, ap := range p {
+			opStr, vStr, err := splitComparatorVersion(ap)
+			if err != nil {
+				return RangeSet{}, err
+			}
+			t, err := buildRangeTerm(opStr, vStr)
+			if err != nil {
+				return RangeSet{}, fmt.Errorf("Could not parse Range %q: %s", ap, err)
+			}
+			alt = append(alt, t)
+		}
+		r.alts = append(r.alts, alt)
+	}
+	return r, nil
+}
+
+// MustParseRangeSet is like ParseRangeSet but panics if the range cannot be parsed.
+func MustParseRangeSet(s string) RangeSet {
+	r, err := ParseRangeSet(s)
+	if err != nil {
+		panic(`semver: ParseRangeSet(` + s + `): ` + err.Error())
+	}
+	return r
+}
+
+// buildRangeTerm takes an operator and a version and builds a rangeTerm,
+// otherwise an error.
+func buildRangeTerm(opStr, vStr string) (rangeTerm, error) {
+	op, ok := parseRangeOp(opStr)
+	if !ok {
+		return rangeTerm{}, fmt.Errorf("Could not parse comparator %q in %q", opStr, opStr+vStr)
+	}
+	v, err := Parse(vStr)
+	if err != nil {
+		return rangeTerm{}, fmt.Errorf("Could not parse version %q in %q: %s", vStr, opStr+vStr, err)
+	}
+	return rangeTerm{op: op, v: v}, nil
+}
@@ -0,0 +1,175 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestRangeSetBuilders(t *testing.T) {
+	v100 := MustParse("1.0.0")
+	v200 := MustParse("2.0.0")
+	type tv struct {
+		v string
+		b bool
+	}
+	tests := []struct {
+		r RangeSet
+		s string
+		v []tv
+	}{
+		{AtLeast(v100), ">=1.0.0", []tv{
+			{"0.9.9", false},
+			{"1.0.0-rc.1", false},
+			{"1.0.0", true},
+			{"3.0.0", true},
+		}},
+		{Below(v100), "<1.0.0", []tv{
+			{"0.9.9", true},
+			{"1.0.0-rc.1", true},
+			{"1.0.0", false},
+		}},
+		{Between(v100, v200, true, false), ">=1.0.0 <2.0.0", []tv{
+			{"0.9.9", false},
+			{"1.0.0", true},
+			{"1.9.9", true},
+			{"2.0.0", false},
+		}},
+		{Between(v100, v200, false, true), ">1.0.0 <=2.0.0", []tv{
+			{"1.0.0", false},
+			{"1.0.1", true},
+			{"2.0.0", true},
+			{"2.0.1", false},
+		}},
+		{Exactly(v100), "1.0.0", []tv{
+			{"1.0.0", true},
+			{"1.0.0+build.1", true},
+			{"1.0.1", false},
+		}},
+		{Except(v100), "!=1.0.0", []tv{
+			{"1.0.0", false},
+			{"1.0.1", true},
+		}},
+		{AtLeast(v100).AND(Below(v200)).AND(Except(MustParse("1.5.0"))), ">=1.0.0 <2.0.0 !=1.5.0", []tv{
+			{"1.4.0", true},
+			{"1.5.0", false},
+			{"2.0.0", false},
+		}},
+		{Below(v100).OR(AtLeast(v200)), "<1.0.0 || >=2.0.0", []tv{
+			{"0.1.0", true},
+			{"1.5.0", false},
+			{"2.0.0", true},
+		}},
+		{Below(v100).OR(Exactly(v200)).AND(Except(MustParse("0.5.0"))), "<1.0.0 !=0.5.0 || 2.0.0 !=0.5.0", []tv{
+			{"0.4.0", true},
+			{"0.5.0", false},
+			{"2.0.0", true},
+			{"2.0.1", false},
+		}},
+		{RangeSet{}, "<0.0.0-0", []tv{
+			{"1.0.0", false},
+		}},
+		{RangeSet{}.OR(Exactly(v100)), "1.0.0", []tv{
+			{"1.0.0", true},
+		}},
+		{RangeSet{}.AND(Exactly(v100)), "<0.0.0-0", []tv{
+			{"1.0.0", false},
+		}},
+	}
+
+	for _, tc := range tests {
+		if s := tc.r.String(); s != tc.s {
+			t.Errorf("Invalid string, expected %q, got %q", tc.s, s)
+		}
+		r := tc.r.Range()
+		for _, tvc := range tc.v {
+			v := MustParse(tvc.v)
+			if res := tc.r.Contains(v); res != tvc.b {
+				t.Errorf("Invalid for case %q matching %q: Expected %t, got: %t", tc.s, tvc.v, tvc.b, res)
+			}
+			if res := r(v); res != tvc.b {
+				t.Errorf("Invalid Range for case %q matching %q: Expected %t, got: %t", tc.s, tvc.v, tvc.b, res)
+			}
+		}
+	}
+}
+
+func TestRangeSetANDDoesNotAlias(t *testing.T) {
+	base := AtLeast(MustParse("1.0.0")).OR(Below(MustParse("0.5.0")))
+	a := base.AND(Except(MustParse("1.1.0")))
+	b := base.AND(Except(MustParse("1.2.0")))
+	if s := a.String(); s != ">=1.0.0 !=1.1.0 || <0.5.0 !=1.1.0" {
+		t.Errorf("Invalid string: %q", s)
+	}
+	if s := b.String(); s != ">=1.0.0 !=1.2.0 || <0.5.0 !=1.2.0" {
+		t.Errorf("Invalid string: %q", s)
+	}
+	if s := base.String(); s != ">=1.0.0 || <0.5.0" {
+		t.Errorf("Invalid string: %q", s)
+	}
+}
+
+func TestParseRangeSet(t *testing.T) {
+	tests := []struct {
+		i string
+		s string
+	}{
+		{">=1.0.0 <2.0.0", ">=1.0.0 <2.0.0"},
+		{"  >=  1.0.0   <2.0.0 ||  == 3.0.0 ", ">=1.0.0 <2.0.0 || 3.0.0"},
+		{"!1.2.3 || =1.2.4", "!=1.2.3 || 1.2.4"},
+		{"1.2.x", ">=1.2.0 <1.3.0"},
+		{">1.x", ">=2.0.0"},
+		{"<=1.0.0-rc.1+build.2", "<=1.0.0-rc.1+build.2"},
+		{RangeSet{}.String(), "<0.0.0-0"},
+		{RangeSet{}.AND(AtLeast(MustParse("1.0.0"))).String(), "<0.0.0-0"},
+	}
+	for _, tc := range tests {
+		r, err := ParseRangeSet(tc.i)
+		if err != nil {
+			t.Errorf("Unexpected error for case %q: %q", tc.i, err)
+			continue
+		}
+		if s := r.String(); s != tc.s {
+			t.Errorf("Invalid for case %q: Expected %q, got: %q", tc.i, tc.s, s)
+		}
+		if r2 := MustParseRangeSet(r.String()); r2.String() != tc.s {
+			t.Errorf("Invalid round trip for case %q: Expected %q, got: %q", tc.i, tc.s, r2.String())
+		}
+	}
+
+	if empty := MustParseRangeSet(RangeSet{}.String()); empty.Contains(minVersion) {
+		t.Errorf("Expected the empty range to match no version")
+	}
+
+	for _, s := range []string{"", "||", ">=1.0.0 ||", "|| 1.0.0", ">>1.0.0", ">=1.0", "foo"} {
+		if _, err := ParseRangeSet(s); err == nil {
+			t.Errorf("Expected error for case %q", s)
+		}
+	}
+}
+
+func TestParseRangeSetMatchesParseRange(t *testing.T) {
+	ranges := []string{
+		">1.0.0 <2.0.0 || >3.0.0 !4.2.1",
+		"<=1.2.x || 2.x",
+		"!=1.0.0-rc.1",
+	}
+	versions := []string{"0.9.0", "1.0.0-rc.1", "1.0.0", "1.2.5", "1.3.0", "2.5.0", "3.0.0", "3.1.0", "4.2.1"}
+	for _, s := range ranges {
+		r := MustParseRange(s)
+		rs := MustParseRangeSet(s)
+		for _, vs := range versions {
+			v := MustParse(vs)
+			if r(v) != rs.Contains(v) {
+				t.Errorf("Mismatch for case %q matching %q: ParseRange %t, ParseRangeSet %t", s, vs, r(v), rs.Contains(v))
+			}
+		}
+	}
+}
+
+func TestMustParseRangeSet_panic(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Should have panicked")
+		}
+	}()
+	_ = MustParseRangeSet("invalid version")
+}
@@ -0,0 +1,81 @@
+package semver
+
+// ReleaseLine is a group of versions sharing the major version, or the
+// major and minor version, see Versions.GroupByMajor and Versions.GroupByMinor.
+type ReleaseLine struct {
+	Major uint64
+	Minor uint64 // Zero in lines grouped by major version
+
+	// Versions of the line, sorted in ascending order
+	Versions Versions
+}
+
+// Latest returns the greatest release of the line, which is its latest
+// patch. ok is false if the line only consists of prereleases.
+func (l ReleaseLine) Latest() (latest Version, ok bool) {
+	for i := len(l.Versions) - 1; i >= 0; i-- {
+		if len(l.Versions[i].Pre) == 0 {
+			return l.Versions[i], true
+		}
+	}
+	return Version{}, false
+}
+
+// First returns the least release of the line.
+// ok is false if the line only consists of prereleases.
+func (l ReleaseLine) First() (first Version, ok bool) {
+	for _, v := range l.Versions {
+		if len(v.Pre) == 0 {
+			return v, true
+		}
+	}
+	return Version{}, false
+}
+
+// Prereleases returns the prerelease versions of the line in ascending order.
+func (l ReleaseLine) Prereleases() Versions {
+	return l.Versions.Prereleases()
+}
+
+// GroupByMajor groups the versions of the collection by their major version.
+// The release lines are returned in ascending order.
+//
+//	vs.GroupByMajor() // 1.x, 2.x, ...
+func (s Versions) GroupByMajor() []ReleaseLine {
+	return s.groupBy(func(a, b Version) bool {
+		return a.Major == b.Major
+	}, false)
+}
+
+// GroupByMinor groups the versions of the collection by their major and
+// minor version. The release lines are returned in ascending order.
+//
+//	vs.GroupByMinor() // 1.0.x, 1.1.x, 2.0.x, ...
+func (s Versions) GroupByMinor() []ReleaseLine {
+	return s.groupBy(func(a, b Version) bool {
+		return a.Major == b.Major && a.Minor == b.Minor
+	}, true)
+}
+
+// groupBy sorts a copy of the collection and splits it into release lines
+// of consecutive versions for which sameLine holds.
+func (s Versions) groupBy(sameLine func(a, b Version) bool, minor bool) []ReleaseLine {
+	sorted := make(Versions, len(s))
+	copy(sorted, s)
+	Sort(sorted)
+
+	var lines []ReleaseLine
+	start := 0
+	for i := 1; i <= len(sorted); i++ {
+		if i < len(sorted) && sameLine(sorted[start], sorted[i]) {
+			continue
+		}
+		l := ReleaseLine{Major: sorted[start].Major, Versions: sorted[start:i:i]}
+		if minor {
+			l.Minor = sorted[start].Minor
+		}
+		lines = append(lines, l)
+		start = i
+	}
+	return lines
+}
@@ -0,0 +1,76 @@
+package semver
+
+import (
+	"testing"
+)
+
+func TestGroupByMinor(t *testing.T) {
+	vs := mustParseVersions("1.1.1", "2.0.0-rc.1", "1.0.0", "1.1.0", "1.1.2-beta", "1.0.1", "1.1.0-rc.1", "1.0.2")
+	lines := vs.GroupByMinor()
+	tests := []struct {
+		major, minor  uint64
+		versions      []string
+		latest, first string
+		prereleases   []string
+	}{
+		{1, 0, []string{"1.0.0", "1.0.1", "1.0.2"}, "1.0.2", "1.0.0", nil},
+		{1, 1, []string{"1.1.0-rc.1", "1.1.0", "1.1.1", "1.1.2-beta"}, "1.1.1", "1.1.0", []string{"1.1.0-rc.1", "1.1.2-beta"}},
+		{2, 0, []string{"2.0.0-rc.1"}, "", "", []string{"2.0.0-rc.1"}},
+	}
+	if len(lines) != len(tests) {
+		t.Fatalf("Invalid number of lines: Expected %d, got %d", len(tests), len(lines))
+	}
+	for i, tc := range tests {
+		l := lines[i]
+		if l.Major != tc.major || l.Minor != tc.minor {
+			t.Errorf("Invalid line %d: Expected %d.%d, got %d.%d", i, tc.major, tc.minor, l.Major, l.Minor)
+		}
+		checkVersions(t, "versions", l.Versions, tc.versions...)
+		checkVersions(t, "prereleases", l.Prereleases(), tc.prereleases...)
+		if latest, ok := l.Latest(); ok != (tc.latest != "") || ok && latest.String() != tc.latest {
+			t.Errorf("Invalid latest of line %d: Expected %q, got %q (%t)", i, tc.latest, latest, ok)
+		}
+		if first, ok := l.First(); ok != (tc.first != "") || ok && first.String() != tc.first {
+			t.Errorf("Invalid first of line %d: Expected %q, got %q (%t)", i, tc.first, first, ok)
+		}
+	}
+
+	// The collection itself is not sorted
+	checkVersions(t, "original", vs, "1.1.1", "2.0.0-rc.1", "1.0.0", "1.1.0", "1.1.2-beta", "1.0.1", "1.1.0-rc.1", "1.0.2")
+}
+
+func TestGroupByMajor(t *testing.T) {
+	vs := mustParseVersions("2.1.0", "1.1.1", "0.1.0", "2.0.0", "1.0.0", "3.0.0-alpha")
+	lines := vs.GroupByMajor()
+	tests := []struct {
+		major    uint64
+		versions []string
+		latest   string
+	}{
+		{0, []string{"0.1.0"}, "0.1.0"},
+		{1, []string{"1.0.0", "1.1.1"}, "1.1.1"},
+		{2, []string{"2.0.0", "2.1.0"}, "2.1.0"},
+		{3, []string{"3.0.0-alpha"}, ""},
+	}
+	if len(lines) != len(tests) {
+		t.Fatalf("Invalid number of lines: Expected %d, got %d", len(tests), len(lines))
+	}
+	for i, tc := range tests {
+		l := lines[i]
+		if l.Major != tc.major || l.Minor != 0 {
+			t.Errorf("Invalid line %d: Expected %d.0, got %d.%d", i, tc.major, l.Major, l.Minor)
+		}
+		checkVersions(t, "versions", l.Versions, tc.versions...)
+		if latest, ok := l.Latest(); ok != (tc.latest != "") || ok && latest.String() != tc.latest {
+			t.Errorf("Invalid latest of line %d: Expected %q, got %q (%t)", i, tc.latest, latest, ok)
+		}
+	}
+
+	// Appending to a line must not overwrite the next one
+	lines[1].Versions = append(lines[1].Versions, MustParse("1.2.0"))
+	checkVersions(t, "next line", lines[2].Versions, "2.0.0", "2.1.0")
+
+	if lines := (Versions{}).GroupByMajor(); len(lines) != 0 {
+		t.Errorf("Expected no lines, got %d", len(lines))
+	}
+}
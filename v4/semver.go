@@ -3,6 +3,7 @@ package semver
 import (
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 )
@@ -173,32 +174,233 @@ func (v Version) Compare(o Version) int {
 
 }
 
-// IncrementPatch increments the patch version
+// CompareStrict compares Versions v to o like Compare, but orders versions
+// of equal precedence by their build meta data, so only versions with
+// identical build meta data are equal:
+// -1 == v is less than o
+// 0 == v is equal to o
+// 1 == v is greater than o
+//
+// Build identifiers are compared from left to right, numeric identifiers
+// numerically and before alphanumeric ones. A version without build meta
+// data comes first: 1.0.0 < 1.0.0+2 < 1.0.0+10 < 1.0.0+b1 < 1.0.0+b1.x
+func (v Version) CompareStrict(o Version) int {
+	if c := v.Compare(o); c != 0 {
+		return c
+	}
+	return compareBuild(v.Build, o.Build)
+}
+
+// StrictEquals checks if v is equal to o including build meta data.
+func (v Version) StrictEquals(o Version) bool {
+	return v.CompareStrict(o) == 0
+}
+
+// IncrementPatch increments the patch version.
+// An error is returned and v is left unchanged if the patch version overflows.
 func (v *Version) IncrementPatch() error {
+	if v.Patch == math.MaxUint64 {
+		return errors.New("Patch version overflow")
+	}
 	v.Patch++
 	return nil
 }
 
-// IncrementMinor increments the minor version
+// IncrementMinor increments the minor version.
+// An error is returned and v is left unchanged if the minor version overflows.
 func (v *Version) IncrementMinor() error {
+	if v.Minor == math.MaxUint64 {
+		return errors.New("Minor version overflow")
+	}
 	v.Minor++
 	v.Patch = 0
 	return nil
 }
 
-// IncrementMajor increments the major version
+// IncrementMajor increments the major version.
+// An error is returned and v is left unchanged if the major version overflows.
 func (v *Version) IncrementMajor() error {
+	if v.Major == math.MaxUint64 {
+		return errors.New("Major version overflow")
+	}
 	v.Major++
 	v.Minor = 0
 	v.Patch = 0
 	return nil
 }
 
+// NextPatch returns the next patch version following the common rule that
+// a prerelease is finalized by the release of the same version:
+// 1.2.3 becomes 1.2.4, while 1.2.3-beta becomes 1.2.3.
+// Unlike IncrementPatch, prerelease and build meta data are discarded.
+// An error is returned if the patch version overflows.
+func (v Version) NextPatch() (Version, error) {
+	n := Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+	if len(v.Pre) > 0 {
+		return n, nil
+	}
+	err := n.IncrementPatch()
+	return n, err
+}
+
+// NextMinor returns the next minor version following the common rule that
+// a prerelease is finalized by the release of the same version:
+// 1.2.3 becomes 1.3.0, 1.3.0-beta becomes 1.3.0, while 1.2.3-beta becomes 1.3.0.
+// Unlike IncrementMinor, prerelease and build meta data are discarded.
+// An error is returned if the minor version overflows.
+func (v Version) NextMinor() (Version, error) {
+	n := Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+	if len(v.Pre) > 0 && v.Patch == 0 {
+		return n, nil
+	}
+	err := n.IncrementMinor()
+	return n, err
+}
+
+// NextMajor returns the next major version following the common rule that
+// a prerelease is finalized by the release of the same version:
+// 1.2.3 becomes 2.0.0, 2.0.0-beta becomes 2.0.0, while 1.2.3-beta becomes 2.0.0.
+// Unlike IncrementMajor, prerelease and build meta data are discarded.
+// An error is returned if the major version overflows.
+func (v Version) NextMajor() (Version, error) {
+	n := Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+	if len(v.Pre) > 0 && v.Minor == 0 && v.Patch == 0 {
+		return n, nil
+	}
+	err := n.IncrementMajor()
+	return n, err
+}
+
+// IncrementPrerelease increments the prerelease version like npm's
+// "prerelease" increment and discards build meta data:
+//
+//	1.2.3-rc.1    becomes  1.2.3-rc.2
+//	1.2.3-rc      becomes  1.2.3-rc.0
+//	1.2.3         becomes  1.2.4-rc.0
+//	1.2.3-beta.2  becomes  1.2.3-rc.0
+//
+// The last numeric prerelease version is incremented, or 0 is appended if
+// there is none. If the first prerelease version differs from id, the
+// prerelease is replaced by id.0. An empty id keeps the existing prerelease,
+// or starts one with 0. An error is returned and v is left unchanged if id
+// is invalid, a number overflows or the result would not be greater than v.
+func (v *Version) IncrementPrerelease(id string) error {
+	if len(v.Pre) == 0 {
+		return v.incrementPre(id, 0, (*Version).IncrementPatch)
+	}
+
+	if len(id) > 0 && (v.Pre[0].IsNum || v.Pre[0].VersionStr != id) {
+		pre, err := prereleaseIDs(id, 0)
+		if err != nil {
+			return err
+		}
+		n := Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch, Pre: pre}
+		if !n.GT(*v) {
+			return fmt.Errorf("Prerelease %q would not increase version %q", id, v.String())
+		}
+		*v = n
+		return nil
+	}
+
+	pre := make([]PRVersion, len(v.Pre), len(v.Pre)+1)
+	copy(pre, v.Pre)
+	i := len(pre) - 1
+	for i >= 0 && !pre[i].IsNum {
+		i--
+	}
+	if i == -1 {
+		pre = append(pre, PRVersion{VersionNum: 0, IsNum: true})
+	} else if len(pre[i].VersionStr) > 0 {
+		pre[i].VersionStr = incrementDecimal(pre[i].VersionStr)
+	} else if pre[i].VersionNum == math.MaxUint64 {
+		return errors.New("Prerelease version overflow")
+	} else {
+		pre[i].VersionNum++
+	}
+	v.Pre = pre
+	v.Build = nil
+	return nil
+}
+
+// IncrementPremajor increments the major version and starts a prerelease
+// with id and base, like npm's "premajor" increment:
+// 1.2.3 becomes 2.0.0-id.base, or 2.0.0-base if id is empty.
+// Build meta data is discarded.
+func (v *Version) IncrementPremajor(id string, base uint64) error {
+	return v.incrementPre(id, base, (*Version).IncrementMajor)
+}
+
+// IncrementPreminor increments the minor version and starts a prerelease
+// with id and base, like npm's "preminor" increment:
+// 1.2.3 becomes 1.3.0-id.base, or 1.3.0-base if id is empty.
+// Build meta data is discarded.
+func (v *Version) IncrementPreminor(id string, base uint64) error {
+	return v.incrementPre(id, base, (*Version).IncrementMinor)
+}
+
+// IncrementPrepatch increments the patch version and starts a prerelease
+// with id and base, like npm's "prepatch" increment:
+// 1.2.3 becomes 1.2.4-id.base, or 1.2.4-base if id is empty.
+// Build meta data is discarded.
+func (v *Version) IncrementPrepatch(id string, base uint64) error {
+	return v.incrementPre(id, base, (*Version).IncrementPatch)
+}
+
+// incrementPre applies inc to the major, minor and patch version of v and
+// starts a prerelease with id and base. v is only changed on success.
+func (v *Version) incrementPre(id string, base uint64, inc func(*Version) error) error {
+	pre, err := prereleaseIDs(id, base)
+	if err != nil {
+		return err
+	}
+	n := Version{Major: v.Major, Minor: v.Minor, Patch: v.Patch}
+	if err := inc(&n); err != nil {
+		return err
+	}
+	n.Pre = pre
+	*v = n
+	return nil
+}
+
+// prereleaseIDs returns the prerelease versions id.base, or base if id is empty.
+func prereleaseIDs(id string, base uint64) ([]PRVersion, error) {
+	num := PRVersion{VersionNum: base, IsNum: true}
+	if len(id) == 0 {
+		return []PRVersion{num}, nil
+	}
+	pr, err := NewPRVersion(id)
+	if err != nil {
+		return nil, err
+	}
+	if pr.IsNum {
+		return nil, fmt.Errorf("Prerelease identifier must not be numeric %q", id)
+	}
+	return []PRVersion{pr, num}, nil
+}
+
+// incrementDecimal increments the decimal number s.
+func incrementDecimal(s string) string {
+	b := []byte(s)
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] != '9' {
+			b[i]++
+			return string(b)
+		}
+		b[i] = '0'
+	}
+	return "1" + string(b)
+}
+
 // Validate validates v and returns error in case
 func (v Version) Validate() error {
 	// Major, Minor, Patch already validated using uint64
 
 	for _, pre := range v.Pre {
+		if pre.IsNum && len(pre.VersionStr) > 0 { // Numeric prerelease version exceeding uint64
+			if !containsOnly(pre.VersionStr, numbers) || hasLeadingZeroes(pre.VersionStr) {
+				return fmt.Errorf("Invalid numeric prerelease %q", pre.VersionStr)
+			}
+		}
 		if !pre.IsNum { //Numeric prerelease versions already uint64
 			if len(pre.VersionStr) == 0 {
 				return fmt.Errorf("Prerelease can not be empty %q", pre.VersionStr)
@@ -267,93 +469,297 @@ func ParseTolerant(s string) (Version, error) {
 	return Parse(s)
 }
 
+// ParseOptions selects the relaxations applied by ParseWithOptions.
+// The zero value applies none, making ParseWithOptions behave like Parse.
+type ParseOptions struct {
+	// TrimSpace removes surrounding whitespace.
+	TrimSpace bool
+	// StripPrefix removes a leading "=" and a "v" or "V" prefix, like in "=v1.2.3".
+	StripPrefix bool
+	// LeadingZeroes allows leading zeroes in major, minor and patch numbers, like in "01.02.03".
+	LeadingZeroes bool
+	// ShortVersion fills up missing minor and patch numbers with 0,
+	// also if followed by prerelease or build meta data, like in "1.2-beta".
+	ShortVersion bool
+	// FourthComponent folds a fourth number into the build meta data,
+	// so "1.2.3.4" becomes "1.2.3+4".
+	FourthComponent bool
+	// LargePrerelease accepts numeric prerelease versions exceeding uint64,
+	// like in "1.0.0-20261017123456789012", see NewLargePRVersion.
+	// Use LargeVersion to decode such versions from JSON, text or SQL.
+	LargePrerelease bool
+}
+
+// ParseWithOptions parses version string s after applying the relaxations
+// selected by opts and returns a validated Version or error.
+func ParseWithOptions(s string, opts ParseOptions) (Version, error) {
+	if opts.TrimSpace {
+		s = strings.TrimSpace(s)
+	}
+	if opts.StripPrefix {
+		s = strings.TrimPrefix(s, "=")
+		if len(s) > 0 && (s[0] == 'v' || s[0] == 'V') {
+			s = s[1:]
+		}
+	}
+	if len(s) == 0 {
+		return Version{}, errors.New("Version string empty")
+	}
+
+	// Split off prerelease and build meta data, keeping their separators
+	var build, prerelease string
+	if i := strings.IndexByte(s, '+'); i != -1 {
+		s, build = s[:i], s[i:]
+	}
+	if i := strings.IndexByte(s, '-'); i != -1 {
+		s, prerelease = s[:i], s[i:]
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) == 4 && opts.FourthComponent {
+		if len(parts[3]) == 0 || !containsOnly(parts[3], numbers) {
+			return Version{}, fmt.Errorf("Invalid character(s) found in fourth number %q", parts[3])
+		}
+		if len(build) > 0 {
+			build = "+" + parts[3] + "." + build[1:]
+		} else {
+			build = "+" + parts[3]
+		}
+		parts = parts[:3]
+	}
+	if len(parts) < 3 && opts.ShortVersion && len(parts[0]) > 0 {
+		for len(parts) < 3 {
+			parts = append(parts, "0")
+		}
+	}
+	if opts.LeadingZeroes {
+		for i, p := range parts {
+			if len(p) > 1 && containsOnly(p, numbers) {
+				if p = strings.TrimLeft(p, "0"); len(p) == 0 {
+					p = "0"
+				}
+				parts[i] = p
+			}
+		}
+	}
+
+	var v Version
+	if err := v.parseInto(strings.Join(parts, ".")+prerelease+build, opts.LargePrerelease); err != nil {
+		return Version{}, err
+	}
+	return v, nil
+}
+
 // Parse parses version string and returns a validated Version or error
 func Parse(s string) (Version, error) {
+	var v Version
+	if err := v.ParseInto(s); err != nil {
+		return Version{}, err
+	}
+	return v, nil
+}
+
+// ParseBytes is like Parse but takes a byte slice. Only versions without
+// prerelease versions and build meta data are parsed without allocating.
+// Otherwise b is copied into a new string, which the prerelease versions
+// and build meta data reference, on top of the allocations of Parse.
+// b may be reused after ParseBytes returns.
+func ParseBytes(b []byte) (Version, error) {
+	var v Version
+	if err := v.ParseBytesInto(b); err != nil {
+		return Version{}, err
+	}
+	return v, nil
+}
+
+// ParseBytesInto is like ParseInto but takes a byte slice. Only versions
+// without prerelease versions and build meta data are parsed without
+// allocating. Otherwise b is copied once into a new string, which the
+// prerelease versions and build meta data reference.
+// b may be reused after ParseBytesInto returns.
+func (v *Version) ParseBytesInto(b []byte) error {
+	var nums [3]uint64
+	n, start := 0, 0
+	for i := 0; i <= len(b) && n < len(nums); i++ {
+		if i < len(b) && b[i] != '.' {
+			continue
+		}
+		num, ok := parseDecimalBytes(b[start:i])
+		if !ok {
+			break
+		}
+		nums[n] = num
+		n++
+		start = i + 1
+	}
+	if n < len(nums) || start <= len(b) {
+		// Prerelease, build meta data or an error, which need the string
+		return v.ParseInto(string(b))
+	}
+	v.Major, v.Minor, v.Patch = nums[0], nums[1], nums[2]
+	v.Pre, v.Build = v.Pre[:0], v.Build[:0]
+	return nil
+}
+
+// parseDecimalBytes parses a decimal number without leading zeroes.
+// ok is false if b is not such a number or overflows uint64.
+func parseDecimalBytes(b []byte) (n uint64, ok bool) {
+	if len(b) == 0 || (len(b) > 1 && b[0] == '0') {
+		return 0, false
+	}
+	for _, c := range b {
+		if c < '0' || c > '9' {
+			return 0, false
+		}
+		d := uint64(c - '0')
+		if n > (math.MaxUint64-d)/10 {
+			return 0, false
+		}
+		n = n*10 + d
+	}
+	return n, true
+}
+
+// ParseInto parses version string s into v, reusing the capacity of v.Pre
+// and v.Build. Parsing does not allocate unless v.Pre or v.Build needs to
+// grow, the parsed prerelease versions and build meta data reference s.
+// If an error is returned, v is reset to the zero version.
+//
+// Copies of v share the backing arrays of v.Pre and v.Build, so parsing
+// into v also changes copies made before. Parse into a fresh Version, or
+// set v.Pre and v.Build to nil first, if v was copied or returned by
+// another function.
+func (v *Version) ParseInto(s string) error {
+	if err := v.parseInto(s, false); err != nil {
+		v.Major, v.Minor, v.Patch = 0, 0, 0
+		v.Pre, v.Build = v.Pre[:0], v.Build[:0]
+		return err
+	}
+	return nil
+}
+
+// parseInto implements ParseInto, large selects whether numeric prerelease
+// versions may exceed uint64.
+func (v *Version) parseInto(s string, large bool) error {
 	if len(s) == 0 {
-		return Version{}, errors.New("Version string empty")
+		return errors.New("Version string empty")
 	}
 
 	// Split into major.minor.(patch+pr+meta)
-	parts := strings.SplitN(s, ".", 3)
-	if len(parts) != 3 {
-		return Version{}, errors.New("No Major.Minor.Patch elements found")
+	i := strings.IndexByte(s, '.')
+	if i == -1 {
+		return errors.New("No Major.Minor.Patch elements found")
+	}
+	j := strings.IndexByte(s[i+1:], '.')
+	if j == -1 {
+		return errors.New("No Major.Minor.Patch elements found")
 	}
+	j += i + 1
+	majorStr, minorStr, patchStr := s[:i], s[i+1:j], s[j+1:]
 
 	// Major
-	if !containsOnly(parts[0], numbers) {
-		return Version{}, fmt.Errorf("Invalid character(s) found in major number %q", parts[0])
+	if !containsOnly(majorStr, numbers) {
+		return fmt.Errorf("Invalid character(s) found in major number %q", majorStr)
 	}
-	if hasLeadingZeroes(parts[0]) {
-		return Version{}, fmt.Errorf("Major number must not contain leading zeroes %q", parts[0])
+	if hasLeadingZeroes(majorStr) {
+		return fmt.Errorf("Major number must not contain leading zeroes %q", majorStr)
 	}
-	major, err := strconv.ParseUint(parts[0], 10, 64)
+	major, err := strconv.ParseUint(majorStr, 10, 64)
 	if err != nil {
-		return Version{}, err
+		return err
 	}
 
 	// Minor
-	if !containsOnly(parts[1], numbers) {
-		return Version{}, fmt.Errorf("Invalid character(s) found in minor number %q", parts[1])
+	if !containsOnly(minorStr, numbers) {
+		return fmt.Errorf("Invalid character(s) found in minor number %q", minorStr)
 	}
-	if hasLeadingZeroes(parts[1]) {
-		return Version{}, fmt.Errorf("Minor number must not contain leading zeroes %q", parts[1])
+	if hasLeadingZeroes(minorStr) {
+		return fmt.Errorf("Minor number must not contain leading zeroes %q", minorStr)
 	}
-	minor, err := strconv.ParseUint(parts[1], 10, 64)
+	minor, err := strconv.ParseUint(minorStr, 10, 64)
 	if err != nil {
-		return Version{}, err
+		return err
 	}
 
-	v := Version{}
-	v.Major = major
-	v.Minor = minor
-
-	var build, prerelease []string
-	patchStr := parts[2]
+	var build, prerelease string
+	hasBuild, hasPrerelease := false, false
 
-	if buildIndex := strings.IndexRune(patchStr, '+'); buildIndex != -1 {
-		build = strings.Split(patchStr[buildIndex+1:], ".")
+	if buildIndex := strings.IndexByte(patchStr, '+'); buildIndex != -1 {
+		build, hasBuild = patchStr[buildIndex+1:], true
 		patchStr = patchStr[:buildIndex]
 	}
 
-	if preIndex := strings.IndexRune(patchStr, '-'); preIndex != -1 {
-		prerelease = strings.Split(patchStr[preIndex+1:], ".")
+	if preIndex := strings.IndexByte(patchStr, '-'); preIndex != -1 {
+		prerelease, hasPrerelease = patchStr[preIndex+1:], true
 		patchStr = patchStr[:preIndex]
 	}
 
 	if !containsOnly(patchStr, numbers) {
-		return Version{}, fmt.Errorf("Invalid character(s) found in patch number %q", patchStr)
+		return fmt.Errorf("Invalid character(s) found in patch number %q", patchStr)
 	}
 	if hasLeadingZeroes(patchStr) {
-		return Version{}, fmt.Errorf("Patch number must not contain leading zeroes %q", patchStr)
+		return fmt.Errorf("Patch number must not contain leading zeroes %q", patchStr)
 	}
 	patch, err := strconv.ParseUint(patchStr, 10, 64)
 	if err != nil {
-		return Version{}, err
+		return err
 	}
 
+	v.Major = major
+	v.Minor = minor
 	v.Patch = patch
+	v.Pre = v.Pre[:0]
+	v.Build = v.Build[:0]
 
 	// Prerelease
-	for _, prstr := range prerelease {
-		parsedPR, err := NewPRVersion(prstr)
-		if err != nil {
-			return Version{}, err
+	if hasPrerelease {
+		if n := strings.Count(prerelease, ".") + 1; cap(v.Pre) < n {
+			v.Pre = make([]PRVersion, 0, n)
+		}
+		for {
+			prstr, rest, more := cutDot(prerelease)
+			parsedPR, err := newPRVersion(prstr, large)
+			if err != nil {
+				return err
+			}
+			v.Pre = append(v.Pre, parsedPR)
+			if !more {
+				break
+			}
+			prerelease = rest
 		}
-		v.Pre = append(v.Pre, parsedPR)
 	}
 
 	// Build meta data
-	for _, str := range build {
-		if len(str) == 0 {
-			return Version{}, errors.New("Build meta data is empty")
+	if hasBuild {
+		if n := strings.Count(build, ".") + 1; cap(v.Build) < n {
+			v.Build = make([]string, 0, n)
 		}
-		if !containsOnly(str, alphanum) {
-			return Version{}, fmt.Errorf("Invalid character(s) found in build meta data %q", str)
+		for {
+			str, rest, more := cutDot(build)
+			if len(str) == 0 {
+				return errors.New("Build meta data is empty")
+			}
+			if !containsOnly(str, alphanum) {
+				return fmt.Errorf("Invalid character(s) found in build meta data %q", str)
+			}
+			v.Build = append(v.Build, str)
+			if !more {
+				break
+			}
+			build = rest
 		}
-		v.Build = append(v.Build, str)
 	}
 
-	return v, nil
+	return nil
+}
+
+// cutDot slices s around the first '.', more reports whether one was found.
+func cutDot(s string) (before, after string, more bool) {
+	if i := strings.IndexByte(s, '.'); i != -1 {
+		return s[:i], s[i+1:], true
+	}
+	return s, "", false
 }
 
 // MustParse is like Parse but panics if the version cannot be parsed.
@@ -374,6 +780,17 @@ type PRVersion struct {
 
 // NewPRVersion creates a new valid prerelease version
 func NewPRVersion(s string) (PRVersion, error) {
+	return newPRVersion(s, false)
+}
+
+// NewLargePRVersion is like NewPRVersion but accepts numeric prerelease
+// versions exceeding uint64. These are kept as decimal string in VersionStr,
+// with VersionNum set to math.MaxUint64, and still compare numerically.
+func NewLargePRVersion(s string) (PRVersion, error) {
+	return newPRVersion(s, true)
+}
+
+func newPRVersion(s string, large bool) (PRVersion, error) {
 	if len(s) == 0 {
 		return PRVersion{}, errors.New("Prerelease is empty")
 	}
@@ -383,10 +800,12 @@ func NewPRVersion(s string) (PRVersion, error) {
 			return PRVersion{}, fmt.Errorf("Numeric PreRelease version must not contain leading zeroes %q", s)
 		}
 		num, err := strconv.ParseUint(s, 10, 64)
-
-		// Might never be hit, but just in case
 		if err != nil {
-			return PRVersion{}, err
+			if !large || !errors.Is(err, strconv.ErrRange) {
+				return PRVersion{}, err
+			}
+			num = math.MaxUint64
+			v.VersionStr = s
 		}
 		v.VersionNum = num
 		v.IsNum = true
@@ -415,7 +834,10 @@ func (v PRVersion) Compare(o PRVersion) int {
 		return 1
 	} else if v.IsNum && o.IsNum {
 		if v.VersionNum == o.VersionNum {
-			return 0
+			if len(v.VersionStr) == 0 && len(o.VersionStr) == 0 {
+				return 0
+			}
+			return compareLargeNum(v.String(), o.String())
 		} else if v.VersionNum > o.VersionNum {
 			return 1
 		} else {
@@ -434,12 +856,62 @@ func (v PRVersion) Compare(o PRVersion) int {
 
 // PreRelease version to string
 func (v PRVersion) String() string {
-	if v.IsNum {
+	if v.IsNum && len(v.VersionStr) == 0 {
 		return strconv.FormatUint(v.VersionNum, 10)
 	}
 	return v.VersionStr
 }
 
+// compareLargeNum compares two decimal numbers without leading zeroes.
+func compareLargeNum(a, b string) int {
+	if len(a) != len(b) {
+		if len(a) > len(b) {
+			return 1
+		}
+		return -1
+	}
+	return strings.Compare(a, b)
+}
+
+// compareBuild compares two lists of build identifiers, see CompareStrict.
+func compareBuild(a, b []string) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if c := compareBuildIdentifier(a[i], b[i]); c != 0 {
+			return c
+		}
+	}
+	if len(a) == len(b) {
+		return 0
+	} else if len(a) < len(b) {
+		return -1
+	}
+	return 1
+}
+
+// compareBuildIdentifier compares two build identifiers. Numeric identifiers
+// may have leading zeroes, which only decide between numerically equal ones.
+func compareBuildIdentifier(a, b string) int {
+	aNum, bNum := containsOnly(a, numbers), containsOnly(b, numbers)
+	if aNum && !bNum {
+		return -1
+	} else if !aNum && bNum {
+		return 1
+	} else if aNum && bNum {
+		if c := compareLargeNum(trimZeroes(a), trimZeroes(b)); c != 0 {
+			return c
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+// trimZeroes removes the leading zeroes of a decimal number.
+func trimZeroes(s string) string {
+	for len(s) > 1 && s[0] == '0' {
+		s = s[1:]
+	}
+	return s
+}
+
 func containsOnly(s string, set string) bool {
 	return strings.IndexFunc(s, func(r rune) bool {
 		return !strings.ContainsRune(set, r)
@@ -1,6 +1,7 @@
 package semver
 
 import (
+	"math"
 	"testing"
 )
 
@@ -74,6 +75,87 @@ func TestParseTolerant(t *testing.T) {
 	}
 }
 
+func TestParseBytes(t *testing.T) {
+	for _, test := range formatTests {
+		b := []byte(test.result)
+		v, err := ParseBytes(b)
+		if err != nil {
+			t.Errorf("Error parsing %q: %q", test.result, err)
+			continue
+		}
+		for i := range b {
+			b[i] = 'x'
+		}
+		if v.String() != test.result {
+			t.Errorf("Parsing, expected %q but got %q", test.result, v)
+		}
+	}
+	for _, test := range wrongformatTests {
+		if res, err := ParseBytes([]byte(test.str)); err == nil {
+			t.Errorf("Parsing wrong format version %q, expected error but got %q", test.str, res)
+		} else if _, perr := Parse(test.str); err.Error() != perr.Error() {
+			t.Errorf("Parsing wrong format version %q, expected error %q but got %q", test.str, perr, err)
+		}
+	}
+	for _, s := range []string{"18446744073709551615.18446744073709551615.18446744073709551615", "18446744073709551616.0.0", "1.2.3.", "01.2.3", "1..3"} {
+		v, err := ParseBytes([]byte(s))
+		pv, perr := Parse(s)
+		if (err == nil) != (perr == nil) || v.String() != pv.String() {
+			t.Errorf("Parsing %q, expected %q (%v) but got %q (%v)", s, pv, perr, v, err)
+		}
+	}
+
+	b := []byte("18446744073709551615.22.333")
+	if n := testing.AllocsPerRun(100, func() {
+		_, _ = ParseBytes(b)
+	}); n != 0 {
+		t.Errorf("Expected no allocations, got %v", n)
+	}
+	var v Version
+	pre := []byte("1.2.3-rc.1+b")
+	_ = v.ParseBytesInto(pre)
+	if n := testing.AllocsPerRun(100, func() {
+		_ = v.ParseBytesInto(pre)
+	}); n != 1 {
+		t.Errorf("Expected a single allocation, got %v", n)
+	}
+	if v.String() != "1.2.3-rc.1+b" {
+		t.Errorf("Parsing, expected %q but got %q", "1.2.3-rc.1+b", v)
+	}
+}
+
+func TestParseInto(t *testing.T) {
+	var v Version
+	for _, test := range formatTests {
+		if err := v.ParseInto(test.result); err != nil {
+			t.Errorf("Error parsing %q: %q", test.result, err)
+		} else if v.String() != test.result {
+			t.Errorf("Parsing, expected %q but got %q", test.result, v)
+		}
+	}
+
+	v = MustParse("1.2.3-alpha.1+build.1")
+	if err := v.ParseInto("1.2.3-"); err == nil {
+		t.Errorf("Expected error")
+	}
+	if v.Major != 0 || v.Minor != 0 || v.Patch != 0 || len(v.Pre) != 0 || len(v.Build) != 0 {
+		t.Errorf("Expected zero version after error, got %q", v)
+	}
+
+	v = MustParse("0.0.1-alpha.preview+123.456")
+	versions := []string{"1.2.3-beta.2+build.5", "4.5.6", "0.0.1-rc+1"}
+	if n := testing.AllocsPerRun(100, func() {
+		for _, s := range versions {
+			_ = v.ParseInto(s)
+		}
+	}); n != 0 {
+		t.Errorf("Expected no allocations, got %v", n)
+	}
+	if v.String() != "0.0.1-rc+1" {
+		t.Errorf("Parsing, expected %q but got %q", "0.0.1-rc+1", v)
+	}
+}
+
 func TestMustParse(t *testing.T) {
 	_ = MustParse("32.2.1-alpha")
 }
@@ -166,6 +248,40 @@ func TestCompare(t *testing.T) {
 	}
 }
 
+var compareStrictTests = []struct {
+	v1     string
+	v2     string
+	result int
+}{
+	{"1.0.0", "1.0.0", 0},
+	{"1.0.0+b1", "1.0.0+b1", 0},
+	{"1.0.0", "1.0.0+b1", -1},
+	{"1.0.0+2", "1.0.0+10", -1},
+	{"1.0.0+10", "1.0.0+b1", -1},
+	{"1.0.0+b1", "1.0.0+b2", -1},
+	{"1.0.0+b1", "1.0.0+b1.x", -1},
+	{"1.0.0+1", "1.0.0+01", 1},
+	{"1.0.0+001", "1.0.0+2", -1},
+	{"1.0.0+99999999999999999999", "1.0.0+100000000000000000000", -1},
+	{"1.0.0+z", "1.0.1+a", -1},
+	{"1.0.0-rc.1+z", "1.0.0+a", -1},
+}
+
+func TestCompareStrict(t *testing.T) {
+	for _, test := range compareStrictTests {
+		v1, v2 := MustParse(test.v1), MustParse(test.v2)
+		if res := v1.CompareStrict(v2); res != test.result {
+			t.Errorf("Comparing %q : %q, expected %d but got %d", test.v1, test.v2, test.result, res)
+		}
+		if res := v2.CompareStrict(v1); res != -test.result {
+			t.Errorf("Comparing %q : %q, expected %d but got %d", test.v2, test.v1, -test.result, res)
+		}
+		if res := v1.StrictEquals(v2); res != (test.result == 0) {
+			t.Errorf("StrictEquals %q : %q, expected %t but got %t", test.v1, test.v2, test.result == 0, res)
+		}
+	}
+}
+
 type wrongformatTest struct {
 	v   *Version
 	str string
@@ -243,6 +359,83 @@ func TestWrongTolerantFormat(t *testing.T) {
 	}
 }
 
+func TestParseWithOptions(t *testing.T) {
+	all := ParseOptions{
+		TrimSpace:       true,
+		StripPrefix:     true,
+		LeadingZeroes:   true,
+		ShortVersion:    true,
+		FourthComponent: true,
+	}
+	tests := []struct {
+		opts   ParseOptions
+		str    string
+		result string
+	}{
+		{ParseOptions{}, "1.2.3-alpha+build", "1.2.3-alpha+build"},
+		{ParseOptions{TrimSpace: true}, " \t1.2.3 ", "1.2.3"},
+		{ParseOptions{StripPrefix: true}, "v1.2.3", "1.2.3"},
+		{ParseOptions{StripPrefix: true}, "V1.2.3", "1.2.3"},
+		{ParseOptions{StripPrefix: true}, "=1.2.3", "1.2.3"},
+		{ParseOptions{StripPrefix: true}, "=v1.2.3", "1.2.3"},
+		{ParseOptions{LeadingZeroes: true}, "01.002.0", "1.2.0"},
+		{ParseOptions{LeadingZeroes: true}, "1.2.03-rc.1", "1.2.3-rc.1"},
+		{ParseOptions{ShortVersion: true}, "1", "1.0.0"},
+		{ParseOptions{ShortVersion: true}, "1.2-beta", "1.2.0-beta"},
+		{ParseOptions{ShortVersion: true}, "1.2+build.1", "1.2.0+build.1"},
+		{ParseOptions{FourthComponent: true}, "1.2.3.4", "1.2.3+4"},
+		{ParseOptions{FourthComponent: true}, "1.2.3.4-rc.1+build", "1.2.3-rc.1+4.build"},
+		{all, " =V01.2-beta.1 ", "1.2.0-beta.1"},
+		{all, "v1.2.3.0004", "1.2.3+0004"},
+	}
+	for _, tc := range tests {
+		v, err := ParseWithOptions(tc.str, tc.opts)
+		if err != nil {
+			t.Errorf("Error parsing %q with %+v: %q", tc.str, tc.opts, err)
+		} else if v.String() != tc.result {
+			t.Errorf("Parsing %q with %+v, expected %q but got %q", tc.str, tc.opts, tc.result, v)
+		}
+	}
+
+	wrong := []struct {
+		opts ParseOptions
+		str  string
+	}{
+		{ParseOptions{}, " 1.2.3"},
+		{ParseOptions{}, "v1.2.3"},
+		{ParseOptions{}, "01.2.3"},
+		{ParseOptions{}, "1.2-beta"},
+		{ParseOptions{}, "1.2.3.4"},
+		{ParseOptions{TrimSpace: true}, "v1.2.3"},
+		{ParseOptions{StripPrefix: true}, "vv1.2.3"},
+		{ParseOptions{LeadingZeroes: true}, "1.2"},
+		{ParseOptions{ShortVersion: true}, "v1.2"},
+		{ParseOptions{FourthComponent: true}, "1.2.3.4.5"},
+		{ParseOptions{FourthComponent: true}, "1.2.3.a"},
+		{ParseOptions{FourthComponent: true}, "1.2.3.4+"},
+		{ParseOptions{StripPrefix: true}, "==1.2.3"},
+		{all, "===v1.2"},
+		{all, ""},
+		{all, "-beta"},
+		{all, "1.2.3-"},
+		{all, "1.2.3-01"},
+	}
+	for _, tc := range wrong {
+		if res, err := ParseWithOptions(tc.str, tc.opts); err == nil {
+			t.Errorf("Parsing wrong format version %q with %+v, expected error but got %q", tc.str, tc.opts, res)
+		}
+	}
+
+	for _, s := range []string{"", "v", "=", " =V "} {
+		if _, err := ParseWithOptions(s, all); err == nil || err.Error() != "Version string empty" {
+			t.Errorf("Parsing %q, expected empty version error but got %v", s, err)
+		}
+	}
+	if _, err := ParseWithOptions("-beta", all); err == nil || err.Error() != "No Major.Minor.Patch elements found" {
+		t.Errorf("Parsing %q, expected missing elements error but got %v", "-beta", err)
+	}
+}
+
 func TestCompareHelper(t *testing.T) {
 	v := Version{1, 0, 0, []PRVersion{prstr("alpha")}, nil}
 	v1 := Version{1, 0, 0, nil, nil}
@@ -301,6 +494,10 @@ var incrementTests = []incrementTest{
 	{Version{0, 1, 2, nil, nil}, PATCH, false, Version{0, 1, 3, nil, nil}},
 	{Version{0, 1, 2, nil, nil}, MINOR, false, Version{0, 2, 0, nil, nil}},
 	{Version{0, 1, 2, nil, nil}, MAJOR, false, Version{1, 0, 0, nil, nil}},
+	{Version{1, 2, math.MaxUint64, nil, nil}, PATCH, true, Version{1, 2, math.MaxUint64, nil, nil}},
+	{Version{1, math.MaxUint64, 3, nil, nil}, MINOR, true, Version{1, math.MaxUint64, 3, nil, nil}},
+	{Version{math.MaxUint64, 2, 3, nil, nil}, MAJOR, true, Version{math.MaxUint64, 2, 3, nil, nil}},
+	{Version{1, 2, math.MaxUint64, nil, nil}, MINOR, false, Version{1, 3, 0, nil, nil}},
 }
 
 func TestIncrements(t *testing.T) {
@@ -322,11 +519,11 @@ func TestIncrements(t *testing.T) {
 			err = test.version.IncrementMajor()
 		}
 		if test.expectingError {
-			if err != nil {
-				t.Errorf("Increment version, expecting %q, got error %q", test.expectedVersion, err)
+			if err == nil {
+				t.Errorf("Increment version %q, expecting error", originalVersion)
 			}
-			if test.version.EQ(originalVersion) {
-				t.Errorf("Increment version, expecting %q, got %q", test.expectedVersion, test.version)
+			if test.version.NE(originalVersion) {
+				t.Errorf("Increment version, expecting unchanged %q, got %q", originalVersion, test.version)
 			}
 		} else {
 			if (err != nil) && !test.expectingError {
@@ -339,6 +536,155 @@ func TestIncrements(t *testing.T) {
 	}
 }
 
+func TestNextVersions(t *testing.T) {
+	tests := []struct {
+		v     string
+		patch string
+		minor string
+		major string
+	}{
+		{"1.2.3", "1.2.4", "1.3.0", "2.0.0"},
+		{"1.2.3+build", "1.2.4", "1.3.0", "2.0.0"},
+		{"1.2.3-beta", "1.2.3", "1.3.0", "2.0.0"},
+		{"1.2.0-beta", "1.2.0", "1.2.0", "2.0.0"},
+		{"1.0.0-beta+build", "1.0.0", "1.0.0", "1.0.0"},
+		{"0.0.0-0", "0.0.0", "0.0.0", "0.0.0"},
+	}
+	for _, tc := range tests {
+		v := MustParse(tc.v)
+		for _, next := range []struct {
+			name     string
+			f        func(Version) (Version, error)
+			expected string
+		}{
+			{"patch", Version.NextPatch, tc.patch},
+			{"minor", Version.NextMinor, tc.minor},
+			{"major", Version.NextMajor, tc.major},
+		} {
+			n, err := next.f(v)
+			if err != nil {
+				t.Errorf("Next %s of %q, not expecting error, got %q", next.name, tc.v, err)
+			} else if n.String() != next.expected {
+				t.Errorf("Next %s of %q, expecting %q, got %q", next.name, tc.v, next.expected, n)
+			}
+		}
+		if v.String() != tc.v {
+			t.Errorf("Next versions of %q modified the version: %q", tc.v, v)
+		}
+	}
+
+	if _, err := (Version{Patch: math.MaxUint64}).NextPatch(); err == nil {
+		t.Errorf("Expected patch overflow error")
+	}
+	if _, err := (Version{Minor: math.MaxUint64}).NextMinor(); err == nil {
+		t.Errorf("Expected minor overflow error")
+	}
+	if _, err := (Version{Major: math.MaxUint64}).NextMajor(); err == nil {
+		t.Errorf("Expected major overflow error")
+	}
+}
+
+func TestIncrementPrerelease(t *testing.T) {
+	tests := []struct {
+		v        string
+		id       string
+		expected string
+	}{
+		{"1.2.3-rc.1", "rc", "1.2.3-rc.2"},
+		{"1.2.3-rc.1", "", "1.2.3-rc.2"},
+		{"1.2.3-rc", "rc", "1.2.3-rc.0"},
+		{"1.2.3-rc.1.beta", "rc", "1.2.3-rc.2.beta"},
+		{"1.2.3", "rc", "1.2.4-rc.0"},
+		{"1.2.3", "", "1.2.4-0"},
+		{"1.2.3-0", "", "1.2.3-1"},
+		{"1.2.3-beta.2", "rc", "1.2.3-rc.0"},
+		{"1.2.3-1", "alpha", "1.2.3-alpha.0"},
+		{"1.2.3-rc.1+build.5", "rc", "1.2.3-rc.2"},
+		{"1.2.3+build.5", "rc", "1.2.4-rc.0"},
+		{"1.2.3-rc.18446744073709551614", "rc", "1.2.3-rc.18446744073709551615"},
+	}
+	for _, tc := range tests {
+		v := MustParse(tc.v)
+		orig := MustParse(tc.v)
+		if err := v.IncrementPrerelease(tc.id); err != nil {
+			t.Errorf("Increment prerelease %q with %q, not expecting error, got %q", tc.v, tc.id, err)
+		} else if v.String() != tc.expected {
+			t.Errorf("Increment prerelease %q with %q, expecting %q, got %q", tc.v, tc.id, tc.expected, v)
+		} else if !v.GT(orig) {
+			t.Errorf("Increment prerelease %q with %q, got smaller version %q", tc.v, tc.id, v)
+		}
+	}
+
+	large, _ := ParseWithOptions("1.0.0-rc.99999999999999999999", ParseOptions{LargePrerelease: true})
+	if err := large.IncrementPrerelease("rc"); err != nil || large.String() != "1.0.0-rc.100000000000000000000" {
+		t.Errorf("Increment large prerelease, got %q, %v", large, err)
+	}
+
+	errorTests := []struct {
+		v  string
+		id string
+	}{
+		{"1.2.3-rc.1", "beta"},
+		{"1.2.3-rc.1", "1"},
+		{"1.2.3-rc.1", "in valid"},
+		{"1.2.3-rc.18446744073709551615", "rc"},
+		{"1.2.18446744073709551615", "rc"},
+		{"1.2.3", "01"},
+	}
+	for _, tc := range errorTests {
+		v := MustParse(tc.v)
+		if err := v.IncrementPrerelease(tc.id); err == nil {
+			t.Errorf("Increment prerelease %q with %q, expecting error, got %q", tc.v, tc.id, v)
+		} else if v.String() != tc.v {
+			t.Errorf("Increment prerelease %q with %q, expecting unchanged version, got %q", tc.v, tc.id, v)
+		}
+	}
+}
+
+func TestIncrementPre(t *testing.T) {
+	tests := []struct {
+		v         string
+		id        string
+		base      uint64
+		major     string
+		minor     string
+		patch     string
+		expectErr bool
+	}{
+		{"1.2.3", "rc", 0, "2.0.0-rc.0", "1.3.0-rc.0", "1.2.4-rc.0", false},
+		{"1.2.3-beta.1+build", "rc", 1, "2.0.0-rc.1", "1.3.0-rc.1", "1.2.4-rc.1", false},
+		{"0.0.0", "", 1, "1.0.0-1", "0.1.0-1", "0.0.1-1", false},
+		{"1.2.3", "1", 0, "", "", "", true},
+		{"1.2.3", "r!c", 0, "", "", "", true},
+		{"18446744073709551615.18446744073709551615.18446744073709551615", "rc", 0, "", "", "", true},
+	}
+	for _, tc := range tests {
+		for _, inc := range []struct {
+			name     string
+			f        func(*Version, string, uint64) error
+			expected string
+		}{
+			{"major", (*Version).IncrementPremajor, tc.major},
+			{"minor", (*Version).IncrementPreminor, tc.minor},
+			{"patch", (*Version).IncrementPrepatch, tc.patch},
+		} {
+			v := MustParse(tc.v)
+			err := inc.f(&v, tc.id, tc.base)
+			if tc.expectErr {
+				if err == nil {
+					t.Errorf("Increment pre%s %q, expecting error, got %q", inc.name, tc.v, v)
+				} else if v.String() != tc.v {
+					t.Errorf("Increment pre%s %q, expecting unchanged version, got %q", inc.name, tc.v, v)
+				}
+			} else if err != nil {
+				t.Errorf("Increment pre%s %q, not expecting error, got %q", inc.name, tc.v, err)
+			} else if v.String() != inc.expected {
+				t.Errorf("Increment pre%s %q, expecting %q, got %q", inc.name, tc.v, inc.expected, v)
+			}
+		}
+	}
+}
+
 func TestPreReleaseVersions(t *testing.T) {
 	p1, err := NewPRVersion("123")
 	if !p1.IsNumeric() {
@@ -362,6 +708,77 @@ func TestPreReleaseVersions(t *testing.T) {
 	}
 }
 
+func TestLargePreReleaseVersions(t *testing.T) {
+	const large = "20261017123456789012"
+	if _, err := NewPRVersion(large); err == nil {
+		t.Errorf("Expected error for %q", large)
+	}
+	p, err := NewLargePRVersion(large)
+	if err != nil {
+		t.Fatalf("Not expected error %q", err)
+	}
+	if !p.IsNumeric() || p.String() != large {
+		t.Errorf("Expected numeric prversion %q, got %q", large, p)
+	}
+	if p2, _ := NewLargePRVersion("123"); p2 != prnum(123) {
+		t.Errorf("Expected regular prversion, got %#v", p2)
+	}
+	for _, s := range []string{"", "0123", "123456789012345678901234567890a!"} {
+		if _, err := NewLargePRVersion(s); err == nil {
+			t.Errorf("Expected error for %q", s)
+		}
+	}
+
+	tests := []struct {
+		a, b string
+		c    int
+	}{
+		{"20261017123456789012", "20261017123456789012", 0},
+		{"20261017123456789012", "20261017123456789013", -1},
+		{"20261017123456789012", "120261017123456789012", -1},
+		{"20261017123456789012", "18446744073709551615", 1},
+		{"18446744073709551616", "18446744073709551615", 1},
+		{"20261017123456789012", "1", 1},
+		{"20261017123456789012", "alpha", -1},
+	}
+	for _, tc := range tests {
+		a, _ := NewLargePRVersion(tc.a)
+		b, _ := NewLargePRVersion(tc.b)
+		if c := a.Compare(b); c != tc.c {
+			t.Errorf("Comparing %q : %q, expected %d but got %d", tc.a, tc.b, tc.c, c)
+		}
+		if c := b.Compare(a); c != -tc.c {
+			t.Errorf("Comparing %q : %q, expected %d but got %d", tc.b, tc.a, -tc.c, c)
+		}
+	}
+
+	opts := ParseOptions{LargePrerelease: true}
+	v, err := ParseWithOptions("1.0.0-20261017123456789012.1", opts)
+	if err != nil {
+		t.Fatalf("Not expected error %q", err)
+	}
+	if v.String() != "1.0.0-20261017123456789012.1" {
+		t.Errorf("Parsing, expected %q but got %q", "1.0.0-20261017123456789012.1", v)
+	}
+	if err := v.Validate(); err != nil {
+		t.Errorf("Not expected validation error %q", err)
+	}
+	if v.Compare(MustParse("1.0.0-18446744073709551615")) != 1 || v.Compare(MustParse("1.0.0")) != -1 {
+		t.Errorf("Invalid comparison for %q", v)
+	}
+	if _, err := Parse("1.0.0-20261017123456789012"); err == nil {
+		t.Errorf("Expected error parsing large prerelease without option")
+	}
+	if _, err := ParseWithOptions("1.0.0-020261017123456789012", opts); err == nil {
+		t.Errorf("Expected error parsing large prerelease with leading zeroes")
+	}
+
+	v.Pre[0].VersionStr = "2026x"
+	if err := v.Validate(); err == nil {
+		t.Errorf("Expected validation error for %q", v)
+	}
+}
+
 func TestBuildMetaDataVersions(t *testing.T) {
 	_, err := NewBuildVersion("123")
 	if err != nil {
@@ -477,6 +894,67 @@ func BenchmarkParseTolerantAverage(b *testing.B) {
 	}
 }
 
+func BenchmarkParseBytesSimple(b *testing.B) {
+	VERSION := []byte("0.0.1")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseBytes(VERSION)
+	}
+}
+
+func BenchmarkParseBytesComplex(b *testing.B) {
+	VERSION := []byte("0.0.1-alpha.preview+123.456")
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseBytes(VERSION)
+	}
+}
+
+func BenchmarkParseBytesAverage(b *testing.B) {
+	l := len(formatTests)
+	inputs := make([][]byte, l)
+	for i, test := range formatTests {
+		inputs[i] = []byte(test.result)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseBytes(inputs[n%l])
+	}
+}
+
+func BenchmarkParseIntoSimple(b *testing.B) {
+	const VERSION = "0.0.1"
+	var v Version
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = v.ParseInto(VERSION)
+	}
+}
+
+func BenchmarkParseIntoComplex(b *testing.B) {
+	const VERSION = "0.0.1-alpha.preview+123.456"
+	var v Version
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = v.ParseInto(VERSION)
+	}
+}
+
+func BenchmarkParseIntoAverage(b *testing.B) {
+	l := len(formatTests)
+	var v Version
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		_ = v.ParseInto(formatTests[n%l].result)
+	}
+}
+
 func BenchmarkStringSimple(b *testing.B) {
 	const VERSION = "0.0.1"
 	v, _ := Parse(VERSION)
@@ -26,3 +26,9 @@ func (s Versions) Less(i, j int) bool {
 func Sort(versions []Version) {
 	sort.Sort(Versions(versions))
 }
+
+// SortStrict sorts a slice of versions by CompareStrict, so versions
+// differing only in build meta data are put in a deterministic order.
+func SortStrict(versions []Version) {
+	SortWith(versions, StrictPrecedence)
+}
@@ -18,6 +18,25 @@ func TestSort(t *testing.T) {
 	}
 }
 
+func TestSortStrict(t *testing.T) {
+	versions := []Version{
+		MustParse("1.0.0+b2"),
+		MustParse("1.0.0+10"),
+		MustParse("0.9.0"),
+		MustParse("1.0.0+b1"),
+		MustParse("1.0.0"),
+		MustParse("1.0.0+2"),
+	}
+	SortStrict(versions)
+
+	correct := []string{"0.9.0", "1.0.0", "1.0.0+2", "1.0.0+10", "1.0.0+b1", "1.0.0+b2"}
+	for i, v := range versions {
+		if v.String() != correct[i] {
+			t.Fatalf("SortStrict returned wrong order: %s", versions)
+		}
+	}
+}
+
 func BenchmarkSort(b *testing.B) {
 	v100, _ := Parse("1.0.0")
 	v010, _ := Parse("0.1.0")
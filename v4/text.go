@@ -0,0 +1,29 @@
+package semver
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (v Version) MarshalText() ([]byte, error) {
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (v *Version) UnmarshalText(data []byte) (err error) {
+	*v, err = Parse(string(data))
+	return
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// Together with Key being comparable, this allows using a Key as JSON map key.
+func (k Key) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// Build meta data is discarded, like by Version.Key.
+func (k *Key) UnmarshalText(data []byte) error {
+	v, err := Parse(string(data))
+	if err != nil {
+		return err
+	}
+	*k = v.Key()
+	return nil
+}
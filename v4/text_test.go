@@ -0,0 +1,99 @@
+package semver
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+)
+
+func TestTextMarshal(t *testing.T) {
+	versionString := "3.1.4-alpha.1.5.9+build.2.6.5"
+	v := MustParse(versionString)
+
+	text, err := v.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(text) != versionString {
+		t.Fatalf("Text marshaled semantic version not equal: expected %q, got %q", versionString, string(text))
+	}
+}
+
+func TestTextUnmarshal(t *testing.T) {
+	versionString := "3.1.4-alpha.1.5.9+build.2.6.5"
+	data := []byte(versionString)
+
+	var v Version
+	if err := v.UnmarshalText(data); err != nil {
+		t.Fatal(err)
+	}
+	data[0] = '9'
+	if v.String() != versionString {
+		t.Fatalf("Text unmarshaled semantic version not equal: expected %q, got %q", versionString, v.String())
+	}
+
+	if err := v.UnmarshalText([]byte("3.1.4.1.5.9.2.6.5-other-digits-of-pi")); err == nil {
+		t.Fatal("expected text unmarshal error, got nil")
+	}
+}
+
+func TestTextJSONMapKey(t *testing.T) {
+	m := map[Key]string{
+		MustParse("1.0.0").Key():            "stable",
+		MustParse("2.0.0-rc.1+build").Key(): "candidate",
+	}
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"1.0.0":"stable","2.0.0-rc.1":"candidate"}`
+	if string(data) != expected {
+		t.Fatalf("JSON marshaled map not equal: expected %q, got %q", expected, string(data))
+	}
+
+	var res map[Key]string
+	if err := json.Unmarshal(data, &res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != len(m) {
+		t.Fatalf("expected %d map entries, got %d", len(m), len(res))
+	}
+	for k, v := range m {
+		if res[k] != v {
+			t.Errorf("unexpected map entry %q: expected %q, got %q", k, v, res[k])
+		}
+	}
+
+	if err := json.Unmarshal([]byte(`{"1.0":"x"}`), &res); err == nil {
+		t.Fatal("expected JSON unmarshal error, got nil")
+	}
+}
+
+func TestTextXML(t *testing.T) {
+	type release struct {
+		XMLName xml.Name `xml:"release"`
+		Version Version  `xml:"version,attr"`
+		Min     Version  `xml:"min"`
+	}
+	r := release{Version: MustParse("1.2.3-beta.1"), Min: MustParse("1.0.0")}
+	data, err := xml.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `<release version="1.2.3-beta.1"><min>1.0.0</min></release>`
+	if string(data) != expected {
+		t.Fatalf("XML marshaled release not equal: expected %q, got %q", expected, string(data))
+	}
+
+	var res release
+	if err := xml.Unmarshal(data, &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Version.String() != "1.2.3-beta.1" || res.Min.String() != "1.0.0" {
+		t.Fatalf("XML unmarshaled release not equal: got %q, %q", res.Version, res.Min)
+	}
+
+	if err := xml.Unmarshal([]byte(`<release version="1.2"></release>`), &res); err == nil {
+		t.Fatal("expected XML unmarshal error, got nil")
+	}
+}
@@ -0,0 +1,112 @@
+package semver
+
+import (
+	"sort"
+	"strings"
+)
+
+// Max returns the greatest version of the collection.
+// ok is false if the collection is empty.
+func (s Versions) Max() (Version, bool) {
+	return MaxWith(s, Precedence)
+}
+
+// Min returns the least version of the collection.
+// ok is false if the collection is empty.
+func (s Versions) Min() (Version, bool) {
+	return MinWith(s, Precedence)
+}
+
+// LatestStable returns the greatest version of the collection which is
+// not a prerelease. ok is false if there is none.
+func (s Versions) LatestStable() (latest Version, ok bool) {
+	for _, v := range s {
+		if len(v.Pre) == 0 && (!ok || v.GT(latest)) {
+			latest, ok = v, true
+		}
+	}
+	return latest, ok
+}
+
+// Stable returns the versions of the collection which are not prereleases,
+// keeping their order.
+func (s Versions) Stable() Versions {
+	var res Versions
+	for _, v := range s {
+		if len(v.Pre) == 0 {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// Prereleases returns the prerelease versions of the collection, keeping
+// their order.
+func (s Versions) Prereleases() Versions {
+	var res Versions
+	for _, v := range s {
+		if len(v.Pre) > 0 {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+// Dedupe returns the collection without duplicates, keeping the first of
+// equal versions and their order. If strict is set, versions are only
+// duplicates if their build meta data is equal too, see StrictEquals.
+func (s Versions) Dedupe(strict bool) Versions {
+	type dedupeKey struct {
+		Key
+		build string
+	}
+	seen := make(map[dedupeKey]struct{}, len(s))
+	res := make(Versions, 0, len(s))
+	for _, v := range s {
+		k := dedupeKey{Key: v.Key()}
+		if strict {
+			k.build = strings.Join(v.Build, ".")
+		}
+		if _, ok := seen[k]; ok {
+			continue
+		}
+		seen[k] = struct{}{}
+		res = append(res, v)
+	}
+	return res
+}
+
+// SortDesc sorts the collection in descending order.
+func (s Versions) SortDesc() {
+	sort.Sort(sort.Reverse(s))
+}
+
+// Reverse reverses the order of the collection.
+func (s Versions) Reverse() {
+	for i, j := 0, len(s)-1; i < j; i, j = i+1, j-1 {
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// Contains checks if the collection contains a version equal to v.
+func (s Versions) Contains(v Version) bool {
+	for _, o := range s {
+		if o.Equals(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// IndexOf returns the index of a version equal to v using binary search,
+// or -1 if there is none. The collection must be sorted in ascending order,
+// e.g. by Sort.
+func (s Versions) IndexOf(v Version) int {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].GE(v)
+	})
+	if i < len(s) && s[i].Equals(v) {
+		return i
+	}
+	return -1
+}
@@ -0,0 +1,101 @@
+package semver
+
+import (
+	"testing"
+)
+
+func mustParseVersions(s ...string) Versions {
+	vs := make(Versions, len(s))
+	for i, str := range s {
+		vs[i] = MustParse(str)
+	}
+	return vs
+}
+
+func checkVersions(t *testing.T, name string, vs Versions, expected ...string) {
+	t.Helper()
+	if len(vs) != len(expected) {
+		t.Errorf("Invalid %s: Expected %q, got %q", name, expected, vs)
+		return
+	}
+	for i, v := range vs {
+		if v.String() != expected[i] {
+			t.Errorf("Invalid %s: Expected %q, got %q", name, expected, vs)
+			return
+		}
+	}
+}
+
+func TestVersionsQueries(t *testing.T) {
+	vs := mustParseVersions("1.2.0", "2.0.0-rc.1", "0.9.0", "1.10.0", "1.0.0-beta")
+	if max, ok := vs.Max(); !ok || max.String() != "2.0.0-rc.1" {
+		t.Errorf("Invalid max: %q (%t)", max, ok)
+	}
+	if min, ok := vs.Min(); !ok || min.String() != "0.9.0" {
+		t.Errorf("Invalid min: %q (%t)", min, ok)
+	}
+	if latest, ok := vs.LatestStable(); !ok || latest.String() != "1.10.0" {
+		t.Errorf("Invalid latest stable: %q (%t)", latest, ok)
+	}
+	checkVersions(t, "stable", vs.Stable(), "1.2.0", "0.9.0", "1.10.0")
+	checkVersions(t, "prereleases", vs.Prereleases(), "2.0.0-rc.1", "1.0.0-beta")
+
+	if !vs.Contains(MustParse("1.10.0+build")) {
+		t.Errorf("Expected to contain 1.10.0")
+	}
+	if vs.Contains(MustParse("1.10.1")) {
+		t.Errorf("Expected not to contain 1.10.1")
+	}
+
+	var empty Versions
+	if _, ok := empty.Max(); ok {
+		t.Errorf("Expected no max")
+	}
+	if _, ok := empty.Min(); ok {
+		t.Errorf("Expected no min")
+	}
+	if _, ok := mustParseVersions("1.0.0-rc.1").LatestStable(); ok {
+		t.Errorf("Expected no latest stable")
+	}
+}
+
+func TestVersionsDedupe(t *testing.T) {
+	vs := mustParseVersions("1.0.0+b2", "1.0.0", "1.1.0-rc.1", "1.0.0+b1", "1.1.0-rc.1", "1.0.0+b2")
+	checkVersions(t, "dedupe", vs.Dedupe(false), "1.0.0+b2", "1.1.0-rc.1")
+	checkVersions(t, "strict dedupe", vs.Dedupe(true), "1.0.0+b2", "1.0.0", "1.1.0-rc.1", "1.0.0+b1")
+	checkVersions(t, "original", vs, "1.0.0+b2", "1.0.0", "1.1.0-rc.1", "1.0.0+b1", "1.1.0-rc.1", "1.0.0+b2")
+}
+
+func TestVersionsOrder(t *testing.T) {
+	vs := mustParseVersions("1.2.0", "2.0.0-rc.1", "0.9.0", "2.0.0")
+	vs.SortDesc()
+	checkVersions(t, "descending order", vs, "2.0.0", "2.0.0-rc.1", "1.2.0", "0.9.0")
+	vs.Reverse()
+	checkVersions(t, "reversed order", vs, "0.9.0", "1.2.0", "2.0.0-rc.1", "2.0.0")
+	vs[:3].Reverse()
+	checkVersions(t, "partly reversed order", vs, "2.0.0-rc.1", "1.2.0", "0.9.0", "2.0.0")
+}
+
+func TestVersionsIndexOf(t *testing.T) {
+	vs := mustParseVersions("0.9.0", "1.0.0-rc.1", "1.0.0", "1.2.0", "2.0.0")
+	tests := []struct {
+		v string
+		i int
+	}{
+		{"0.9.0", 0},
+		{"1.0.0-rc.1", 1},
+		{"1.0.0+build", 2},
+		{"2.0.0", 4},
+		{"0.1.0", -1},
+		{"1.1.0", -1},
+		{"3.0.0", -1},
+	}
+	for _, tc := range tests {
+		if i := vs.IndexOf(MustParse(tc.v)); i != tc.i {
+			t.Errorf("Invalid index of %q: Expected %d, got %d", tc.v, tc.i, i)
+		}
+	}
+	if i := (Versions{}).IndexOf(MustParse("1.0.0")); i != -1 {
+		t.Errorf("Invalid index in empty versions: %d", i)
+	}
+}